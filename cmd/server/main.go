@@ -4,13 +4,16 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
-	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
 	"github.com/krmcbride/mcp-k8s/internal/prompts"
 	"github.com/krmcbride/mcp-k8s/internal/resources"
 	"github.com/krmcbride/mcp-k8s/internal/tools"
@@ -24,17 +27,141 @@ var (
 )
 
 const (
-	serverName = "mcp-k8s"
+	serverName             = "mcp-k8s"
+	kubeconfigEnvVar       = "MCP_K8S_KUBECONFIG"
+	maxResponseEnvVar      = "MCP_K8S_MAX_RESPONSE_BYTES"
+	maxListEnvVar          = "MCP_K8S_MAX_LIST_LIMIT"
+	maxLogEnvVar           = "MCP_K8S_MAX_LOG_BYTES"
+	proxyURLEnvVar         = "MCP_K8S_PROXY_URL"
+	caFileEnvVar           = "MCP_K8S_CA_FILE"
+	disableToolEnvVar      = "MCP_K8S_DISABLE_TOOLS"
+	disablePromptEnvVar    = "MCP_K8S_DISABLE_PROMPTS"
+	allowNamespaceEnvVar   = "MCP_K8S_ALLOW_NAMESPACES"
+	denyNamespaceEnvVar    = "MCP_K8S_DENY_NAMESPACES"
+	instructionsEnvVar     = "MCP_K8S_INSTRUCTIONS_FILE"
+	instructionsModeEnvVar = "MCP_K8S_INSTRUCTIONS_MODE"
+	logLevelEnvVar         = "MCP_K8S_LOG_LEVEL"
+	redactEnvVar           = "MCP_K8S_REDACT"
+	redactPatternEnvVar    = "MCP_K8S_REDACT_PATTERN"
 )
 
+// Supported values for the -instructions-mode flag
+const (
+	instructionsAppend  = "append"
+	instructionsReplace = "replace"
+)
+
+// defaultInstructions are sent to clients at initialization to describe the server
+const defaultInstructions = `
+This MCP server provides safe, read-only access to Kubernetes clusters through structured tools and resources.
+
+**Key Features:**
+- Safe by design: All operations are read-only, no cluster modifications possible
+- No kubectl required: Direct API access through kubeconfig contexts
+- Context discovery: Use 'kubeconfig://contexts' MCP resource (or the list_k8s_contexts tool) to find available clusters, or 'kubeconfig://current-context' for just the default context
+- Comprehensive analysis: Built-in prompts for memory pressure, workload instability, node capacity, crash loop, and certificate expiry analysis
+
+**Available Tools:**
+- list_k8s_resources: List and filter Kubernetes resources with smart formatting
+- list_k8s_resources_multi: List several kinds (e.g. Pods, Deployments, Events) in one namespace in a single call
+- count_k8s_resources: Count matching resources without fetching them
+- list_k8s_namespace_inventory: Count objects of every resource type in a namespace
+- get_k8s_namespace_graph: Get a namespace's ownership and selector topology as nodes and edges
+- list_k8s_contexts: List kubeconfig contexts (same as the kubeconfig://contexts resource)
+- list_k8s_api_resources: Discover available API resource types (like kubectl api-resources)
+- resolve_k8s_kind: Resolve a kind or short name to its canonical group/version/resource before listing or getting
+- get_k8s_resource: Fetch individual resources with optional Go template formatting or raw JSON/YAML output
+- k8s_resource_exists: Check whether a resource exists without fetching it
+- k8s_can_i: Check whether the current credentials can perform a verb on a resource (like kubectl auth can-i)
+- get_k8s_metrics: Get CPU/memory metrics for nodes and pods (like kubectl top)
+- compare_k8s_pod_metrics: Compare two pod metrics samples taken an interval apart and flag memory growth that may be a leak
+- list_k8s_pods_on_node: List all pods running on a node across namespaces
+- list_k8s_workload_pods: List the pods belonging to a Deployment, StatefulSet, or DaemonSet
+- list_k8s_warnings: List Warning events across all namespaces, aggregated and most recent first
+- list_k8s_restarting_pods: Find pods with recent container restarts, ranked by restart count
+- get_k8s_pod_logs: Retrieve pod logs with filtering options
+- get_k8s_pod_logs_by_selector: Retrieve logs from all pods matching a label selector
+- wait_k8s_resource: Poll a resource until a condition is met (like kubectl wait)
+- watch_k8s_resources: Watch resources for changes for a bounded time (like kubectl get -w)
+- explain_k8s_resource: Describe resource fields from the OpenAPI schema (like kubectl explain)
+- get_k8s_resource_schema: Get the full OpenAPI v3 schema of a CRD-backed kind
+- check_k8s_service_endpoints: Check whether a Service has ready endpoints and which pods back it
+- get_k8s_rollout_status: Check the rollout status of a Deployment, StatefulSet, or DaemonSet
+- get_k8s_hpa_status: Explain an HPA's scaling decisions using its target's replicas and pod metrics
+
+**Context Usage:**
+Instead of running kubectl commands, use the kubeconfig://contexts MCP resource to discover available cluster contexts. This server resolves cluster aliases (like 'prod', 'staging') to actual kubeconfig contexts automatically.
+
+**Analysis Prompts:**
+- memory_pressure_analysis: Systematic analysis of pod memory usage and OOM issues
+- workload_instability_analysis: Investigation of Events and logs for instability patterns
+- node_capacity_analysis: Detection of node CPU/memory saturation and eviction risk
+- crashloop_analysis: Ranking of crash-looping containers with root causes from previous logs
+- certificate_expiry_analysis: Detection of expiring or not Ready cert-manager Certificates
+
+All tools support CRDs and custom resources automatically through dynamic client discovery.`
+
 // WARN: only log to stderr to prevent interference with stdio transport
 // See: https://modelcontextprotocol.io/docs/tools/debugging#implementing-logging
 func main() {
 	var showHelp bool
 	var showVersion bool
+	var kubeconfig string
+	var maxResponseBytes int
+	var maxListLimit int
+	var maxLogBytes int
+	var proxyURL string
+	var caFile string
+	var instructionsFile string
+	var instructionsMode string
+	var logLevel string
+	var redact bool
+	var redactPattern string
+	disabledTools := envList(disableToolEnvVar)
+	disabledPrompts := envList(disablePromptEnvVar)
+	allowedNamespaces := envList(allowNamespaceEnvVar)
+	deniedNamespaces := envList(denyNamespaceEnvVar)
 
 	flag.BoolVar(&showHelp, "help", false, "Show help information")
 	flag.BoolVar(&showVersion, "version", false, "Show version information")
+	flag.StringVar(&kubeconfig, "kubeconfig", os.Getenv(kubeconfigEnvVar),
+		"Path to an explicit kubeconfig file (overrides KUBECONFIG and ~/.kube/config; defaults to $"+kubeconfigEnvVar+")")
+	flag.IntVar(&maxResponseBytes, "max-response-bytes", envInt(maxResponseEnvVar, tools.DefaultMaxResponseBytes),
+		"Truncate tool responses larger than this many bytes with a warning; 0 disables (defaults to $"+maxResponseEnvVar+")")
+	flag.IntVar(&maxListLimit, "max-list-limit", envInt(maxListEnvVar, tools.DefaultMaxListLimit),
+		"Clamp list_k8s_resources limits above this page size; 0 disables (defaults to $"+maxListEnvVar+")")
+	flag.IntVar(&maxLogBytes, "max-log-bytes", envInt(maxLogEnvVar, tools.DefaultMaxLogBytes),
+		"Stop reading a container's log after this many bytes, with a truncation marker; 0 disables (defaults to $"+maxLogEnvVar+")")
+	flag.StringVar(&proxyURL, "proxy-url", os.Getenv(proxyURLEnvVar),
+		"HTTP(S) proxy for API server requests (overrides HTTPS_PROXY; defaults to $"+proxyURLEnvVar+")")
+	flag.StringVar(&caFile, "ca-file", os.Getenv(caFileEnvVar),
+		"Extra PEM CA bundle to trust in addition to the kubeconfig cluster CA (defaults to $"+caFileEnvVar+")")
+	flag.StringVar(&instructionsFile, "instructions-file", os.Getenv(instructionsEnvVar),
+		"File with environment-specific guidance added to the server instructions sent to clients (defaults to $"+instructionsEnvVar+")")
+	flag.StringVar(&instructionsMode, "instructions-mode", envString(instructionsModeEnvVar, instructionsAppend),
+		"How -instructions-file combines with the built-in instructions: 'append' or 'replace' (defaults to $"+instructionsModeEnvVar+")")
+	flag.StringVar(&logLevel, "log-level", envString(logLevelEnvVar, "info"),
+		"Log level for stderr logging: debug, info, warn, or error; debug logs each exec credential plugin invocation (defaults to $"+logLevelEnvVar+")")
+	flag.BoolVar(&redact, "redact", envBool(redactEnvVar, false),
+		"Mask values of keys that look like credentials (password, token, secret, ...) in tool and resource template output; pod log text is not redacted (defaults to $"+redactEnvVar+")")
+	flag.StringVar(&redactPattern, "redact-pattern", os.Getenv(redactPatternEnvVar),
+		"Case-insensitive regex of keys whose values are masked in tool and resource template output; implies -redact (defaults to $"+redactPatternEnvVar+")")
+	flag.Func("disable-tool", "Do not register the named tool (get_k8s_resource also hides the k8s:// resource template); repeatable (also $"+disableToolEnvVar+", comma-separated)", func(name string) error {
+		disabledTools = append(disabledTools, name)
+		return nil
+	})
+	flag.Func("disable-prompt", "Do not register the named prompt; repeatable (also $"+disablePromptEnvVar+", comma-separated)", func(name string) error {
+		disabledPrompts = append(disabledPrompts, name)
+		return nil
+	})
+	flag.Func("allow-namespace", "Only allow tools and the k8s:// resource template to access the named namespace; repeatable (also $"+allowNamespaceEnvVar+", comma-separated)", func(name string) error {
+		allowedNamespaces = append(allowedNamespaces, name)
+		return nil
+	})
+	flag.Func("deny-namespace", "Deny tools and the k8s:// resource template access to the named namespace; repeatable (also $"+denyNamespaceEnvVar+", comma-separated)", func(name string) error {
+		deniedNamespaces = append(deniedNamespaces, name)
+		return nil
+	})
 	flag.Parse()
 
 	if showHelp {
@@ -59,78 +186,156 @@ func main() {
 		os.Exit(0)
 	}
 
-	// Initialize the MCP server
-	s := server.NewMCPServer(
-		serverName,
-		version,
-		server.WithInstructions(`
-This MCP server provides safe, read-only access to Kubernetes clusters through structured tools and resources.
+	// Log to stderr only, since stdout carries the MCP protocol
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(logLevel)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid -log-level %q: must be debug, info, warn, or error\n", logLevel)
+		os.Exit(1)
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
 
-**Key Features:**
-- Safe by design: All operations are read-only, no cluster modifications possible
-- No kubectl required: Direct API access through kubeconfig contexts
-- Context discovery: Use 'kubeconfig://contexts' MCP resource to find available clusters
-- Comprehensive analysis: Built-in prompts for memory pressure and workload instability analysis
+	// Report exec credential plugin (EKS/GKE/AKS auth) invocations at debug level
+	k8s.EnableExecPluginLogging()
 
-**Available Tools:**
-- list_k8s_resources: List and filter Kubernetes resources with smart formatting
-- list_k8s_api_resources: Discover available API resource types (like kubectl api-resources)
-- get_k8s_resource: Fetch individual resources with optional Go template formatting
-- get_k8s_metrics: Get CPU/memory metrics for nodes and pods (like kubectl top)
-- get_k8s_pod_logs: Retrieve pod logs with filtering options
+	// Use an explicit kubeconfig file if one was provided
+	k8s.SetKubeconfigPath(kubeconfig)
 
-**Context Usage:**
-Instead of running kubectl commands, use the kubeconfig://contexts MCP resource to discover available cluster contexts. This server resolves cluster aliases (like 'prod', 'staging') to actual kubeconfig contexts automatically.
+	// Identify this server in API server audit logs
+	k8s.SetUserAgent(serverName, version)
 
-**Analysis Prompts:**
-- memory_pressure_analysis: Systematic analysis of pod memory usage and OOM issues
-- workload_instability_analysis: Investigation of Events and logs for instability patterns
+	// Apply proxy and extra CA settings to all clients
+	if err := k8s.SetProxyURL(proxyURL); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	k8s.SetExtraCAFile(caFile)
+
+	// Guard against responses exceeding the MCP tool response limit
+	tools.SetMaxResponseBytes(maxResponseBytes)
+	tools.SetMaxListLimit(maxListLimit)
+	tools.SetMaxLogBytes(maxLogBytes)
+
+	// Mask sensitive values such as passwords and tokens in tool output
+	if redact && redactPattern == "" {
+		redactPattern = tools.DefaultRedactPattern
+	}
+	if err := tools.SetRedactPattern(redactPattern); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Restrict which namespaces tools may access; all-namespaces queries are rejected when set
+	tools.SetNamespacePolicy(allowedNamespaces, deniedNamespaces)
+
+	// Merge operator-provided guidance into the instructions clients receive at initialization
+	instructions, err := buildInstructions(instructionsFile, instructionsMode)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
 
-All tools support CRDs and custom resources automatically through dynamic client discovery.`),
+	// Initialize the MCP server
+	s := server.NewMCPServer(
+		serverName,
+		version,
+		server.WithInstructions(instructions),
 		server.WithToolCapabilities(false),
 		server.WithResourceCapabilities(false, false),
 		server.WithPromptCapabilities(false),
 		server.WithRecovery(),
 	)
 
-	// Register prompts, resources, and tools
-	prompts.RegisterMCPPrompts(s)
+	// Register prompts, resources, and tools, skipping any the operator disabled
+	prompts.SetDisabledPrompts(disabledPrompts)
+	if err := prompts.RegisterMCPPrompts(s); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	tools.SetDisabledTools(disabledTools)
 	resources.RegisterMCPResources(s)
-	tools.RegisterMCPTools(s)
+	if err := tools.RegisterMCPTools(s); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Cancel the root context on SIGINT/SIGTERM. Tool handlers receive contexts derived from it,
+	// so in-flight Kubernetes requests are aborted instead of outliving the server.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Listen returns once the context is canceled and the in-flight request has finished
+	fmt.Fprintf(os.Stderr, "Starting MCP server %s %s\n", serverName, version)
+	err = server.NewStdioServer(s).Listen(ctx, os.Stdin, os.Stdout)
+	if ctx.Err() != nil {
+		fmt.Fprintf(os.Stderr, "Received shutdown signal, shutting down gracefully...\n")
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
+		stop()
+		os.Exit(1)
+	}
 
-	// Set up signal handling
-	_, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	fmt.Fprintf(os.Stderr, "Server shutdown complete\n")
+}
 
-	// Channel to receive OS signals
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+// buildInstructions combines the default instructions with the contents of an operator-provided
+// file, either appending them as an environment-specific section or replacing the defaults
+func buildInstructions(path, mode string) (string, error) {
+	if mode != instructionsAppend && mode != instructionsReplace {
+		return "", fmt.Errorf("-instructions-mode must be '%s' or '%s', got '%s'", instructionsAppend, instructionsReplace, mode)
+	}
+	if path == "" {
+		return defaultInstructions, nil
+	}
 
-	// Channel to receive server errors
-	errChan := make(chan error, 1)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read instructions file: %w", err)
+	}
+	extra := strings.TrimSpace(string(data))
 
-	// Start the server in a goroutine
-	go func() {
-		fmt.Fprintf(os.Stderr, "Starting MCP server %s %s\n", serverName, version)
-		if err := server.ServeStdio(s); err != nil {
-			errChan <- err
+	if extra == "" {
+		if mode == instructionsReplace {
+			return "", fmt.Errorf("instructions file %s is empty; refusing to replace the default instructions", path)
 		}
-	}()
+		return defaultInstructions, nil
+	}
+	if mode == instructionsReplace {
+		return extra, nil
+	}
+	return defaultInstructions + "\n\n**Environment-Specific Guidance:**\n" + extra, nil
+}
 
-	// Wait for either a signal or an error
-	select {
-	case sig := <-sigChan:
-		fmt.Fprintf(os.Stderr, "Received signal %v, shutting down gracefully...\n", sig)
-		cancel()
+// envString reads a string environment variable, falling back to def if unset or empty
+func envString(name, def string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return def
+}
 
-		// Give the server a moment to clean up
-		time.Sleep(100 * time.Millisecond)
+// envList reads a comma-separated environment variable, ignoring empty entries
+func envList(name string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(name), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
 
-	case err := <-errChan:
-		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
-		cancel()
-		os.Exit(1)
+// envBool reads a boolean environment variable, falling back to def if unset or invalid
+func envBool(name string, def bool) bool {
+	if value, err := strconv.ParseBool(os.Getenv(name)); err == nil {
+		return value
 	}
+	return def
+}
 
-	fmt.Fprintf(os.Stderr, "Server shutdown complete\n")
+// envInt reads an integer environment variable, falling back to def if unset or invalid
+func envInt(name string, def int) int {
+	if value, err := strconv.Atoi(os.Getenv(name)); err == nil {
+		return value
+	}
+	return def
 }
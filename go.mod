@@ -4,10 +4,13 @@ go 1.24.3
 
 require (
 	github.com/mark3labs/mcp-go v0.32.0
+	github.com/robfig/cron/v3 v3.0.1
+	golang.org/x/sync v0.12.0
 	k8s.io/api v0.33.1
 	k8s.io/apimachinery v0.33.1
 	k8s.io/client-go v0.33.1
 	k8s.io/metrics v0.33.1
+	sigs.k8s.io/yaml v1.4.0
 )
 
 require (
@@ -49,5 +52,4 @@ require (
 	sigs.k8s.io/json v0.0.0-20241010143419-9aa6b5e7a4b3 // indirect
 	sigs.k8s.io/randfill v1.0.0 // indirect
 	sigs.k8s.io/structured-merge-diff/v4 v4.6.0 // indirect
-	sigs.k8s.io/yaml v1.4.0 // indirect
 )
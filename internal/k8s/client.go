@@ -4,17 +4,85 @@ package k8s
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
+// kubeconfigPath is an explicit kubeconfig file to load instead of the default loading rules.
+// Empty means use the standard KUBECONFIG env / ~/.kube/config resolution.
+var kubeconfigPath string
+
+// SetKubeconfigPath configures an explicit kubeconfig file for all clients.
+// It should be called once at startup, before any clients are created.
+func SetKubeconfigPath(path string) {
+	kubeconfigPath = path
+}
+
+// NewConfigLoadingRules returns the kubeconfig loading rules used by all clients.
+// If an explicit kubeconfig path was configured, exactly that file is loaded;
+// otherwise the standard loading rules apply (KUBECONFIG env, then ~/.kube/config).
+func NewConfigLoadingRules() *clientcmd.ClientConfigLoadingRules {
+	if kubeconfigPath != "" {
+		return &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath}
+	}
+	return clientcmd.NewDefaultClientConfigLoadingRules()
+}
+
+// proxyURL, if set, routes all API server requests through this HTTP(S) proxy instead of
+// the proxy from the HTTPS_PROXY/HTTP_PROXY environment variables.
+var proxyURL *url.URL
+
+// extraCAFile is an additional CA bundle trusted alongside the kubeconfig's cluster CA.
+var extraCAFile string
+
+// SetProxyURL configures an explicit HTTP(S) proxy for all clients. An empty string keeps
+// client-go's default of honoring the HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment variables.
+// It should be called once at startup, before any clients are created.
+func SetProxyURL(rawURL string) error {
+	if rawURL == "" {
+		proxyURL = nil
+		return nil
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL %q: %w", rawURL, err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("invalid proxy URL %q: must include a scheme and host (e.g. http://proxy:3128)", rawURL)
+	}
+
+	proxyURL = parsed
+	return nil
+}
+
+// SetExtraCAFile configures an additional PEM CA bundle that is merged into every client's
+// trusted CAs. It should be called once at startup, before any clients are created.
+func SetExtraCAFile(path string) {
+	extraCAFile = path
+}
+
+// userAgent identifies this server in API server audit logs
+var userAgent = "mcp-k8s/dev"
+
+// SetUserAgent sets the User-Agent sent by all clients to "<name>/<version>".
+// It should be called once at startup, before any clients are created.
+func SetUserAgent(name, version string) {
+	userAgent = fmt.Sprintf("%s/%s", name, version)
+}
+
 // k8sClients bundles together Kubernetes clients needed for dynamic operations.
 // This includes both the dynamic client (for CRUD operations on any resource type)
 // and the REST mapper (for converting between Kinds and Resources).
@@ -60,9 +128,7 @@ func GetDynamicClientForContext(k8sContext string) (dynamic.Interface, error) {
 //	client, err := GetMetricsClientForContext("production")
 //	podMetrics, err := client.MetricsV1beta1().PodMetricses("default").List(metav1.ListOptions{})
 func GetMetricsClientForContext(k8sContext string) (metrics.Interface, error) {
-	kubeConfig := getKubeConfigForContext(k8sContext)
-
-	config, err := kubeConfig.ClientConfig()
+	config, err := getRESTConfigForContext(k8sContext)
 	if err != nil {
 		return nil, err
 	}
@@ -90,9 +156,7 @@ func GetMetricsClientForContext(k8sContext string) (metrics.Interface, error) {
 //	clientset, err := GetClientsetForContext("production")
 //	pods, err := clientset.CoreV1().Pods("default").List(ctx, metav1.ListOptions{})
 func GetClientsetForContext(k8sContext string) (kubernetes.Interface, error) {
-	kubeConfig := getKubeConfigForContext(k8sContext)
-
-	config, err := kubeConfig.ClientConfig()
+	config, err := getRESTConfigForContext(k8sContext)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +169,35 @@ func GetClientsetForContext(k8sContext string) (kubernetes.Interface, error) {
 	return clientset, nil
 }
 
+// GetMetadataClientForContext creates a Kubernetes metadata client for the specified context.
+// A metadata client lists and gets resources as PartialObjectMetadata (only apiVersion, kind
+// and metadata), which is far cheaper than fetching full objects for counting or name lookups.
+//
+// Parameters:
+//   - k8sContext: The name of the kubeconfig context to use. If empty, uses the current context.
+//
+// Returns:
+//   - A metadata client interface for metadata-only operations on any resource type
+//   - An error if the client creation fails (e.g., invalid context, connection issues)
+//
+// Example usage:
+//
+//	client, err := GetMetadataClientForContext("production")
+//	pods, err := client.Resource(podGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+func GetMetadataClientForContext(k8sContext string) (metadata.Interface, error) {
+	config, err := getRESTConfigForContext(k8sContext)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataClient, err := metadata.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadataClient, nil
+}
+
 // GetDiscoveryClientForContext creates a Kubernetes discovery client for the specified context.
 // A discovery client provides access to API resource discovery (equivalent to kubectl api-resources).
 //
@@ -120,11 +213,9 @@ func GetClientsetForContext(k8sContext string) (kubernetes.Interface, error) {
 //	client, err := GetDiscoveryClientForContext("production")
 //	resources, err := client.ServerGroupsAndResources()
 func GetDiscoveryClientForContext(k8sContext string) (discovery.DiscoveryInterface, error) {
-	kubeConfig := getKubeConfigForContext(k8sContext)
-
-	config, err := kubeConfig.ClientConfig()
+	config, err := getRESTConfigForContext(k8sContext)
 	if err != nil {
-		return nil, enhanceContextError(err)
+		return nil, err
 	}
 
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
@@ -144,11 +235,9 @@ func GetDiscoveryClientForContext(k8sContext string) (discovery.DiscoveryInterfa
 // This bundling is useful because operations that need dynamic clients often also need
 // REST mapping capabilities (e.g., converting "Pod" to "pods").
 func getClientsForContext(k8sContext string) (*k8sClients, error) {
-	kubeConfig := getKubeConfigForContext(k8sContext)
-
-	config, err := kubeConfig.ClientConfig()
+	config, err := getRESTConfigForContext(k8sContext)
 	if err != nil {
-		return nil, enhanceContextError(err)
+		return nil, err
 	}
 
 	// Create dynamic client
@@ -163,10 +252,11 @@ func getClientsForContext(k8sContext string) (*k8sClients, error) {
 		return nil, err
 	}
 
-	// Create REST mapper
+	// Create REST mapper. This is the first request to the cluster, so classify connection
+	// and credential failures here.
 	groupResources, err := restmapper.GetAPIGroupResources(discoveryClient)
 	if err != nil {
-		return nil, err
+		return nil, ClassifyClusterError(err)
 	}
 	restMapper := restmapper.NewDiscoveryRESTMapper(groupResources)
 
@@ -176,17 +266,93 @@ func getClientsForContext(k8sContext string) (*k8sClients, error) {
 	}, nil
 }
 
+// GetContextNamespace returns the default namespace configured on a kubeconfig context,
+// or an empty string if the context doesn't set one. Unlike ClientConfig.Namespace(), this
+// doesn't fall back to "default", so callers can distinguish an unset namespace.
+//
+// Parameters:
+//   - k8sContext: The name of the kubeconfig context to use. If empty, uses the current context.
+func GetContextNamespace(k8sContext string) (string, error) {
+	rawConfig, err := getKubeConfigForContext(k8sContext).RawConfig()
+	if err != nil {
+		return "", err
+	}
+
+	contextName := k8sContext
+	if contextName == "" {
+		contextName = rawConfig.CurrentContext
+	}
+
+	kubeContext, ok := rawConfig.Contexts[contextName]
+	if !ok {
+		return "", enhanceContextError(fmt.Errorf("context %q does not exist", contextName))
+	}
+
+	return kubeContext.Namespace, nil
+}
+
+// Helper that builds the REST config for a specific context and applies the server-wide
+// client settings (User-Agent, proxy and extra CA bundle). All client builders go through this so every
+// tool benefits from the same configuration.
+func getRESTConfigForContext(k8sContext string) (*rest.Config, error) {
+	config, err := getKubeConfigForContext(k8sContext).ClientConfig()
+	if err != nil {
+		return nil, enhanceContextError(err)
+	}
+
+	if err := applyClientSettings(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// applyClientSettings applies the configured User-Agent, proxy and extra CA bundle to a REST config
+func applyClientSettings(config *rest.Config) error {
+	config.UserAgent = userAgent
+
+	if proxyURL != nil {
+		config.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	// CAs are irrelevant when TLS verification is disabled
+	if extraCAFile != "" && !config.Insecure {
+		extraCA, err := os.ReadFile(extraCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read extra CA file: %w", err)
+		}
+
+		// CAData takes precedence over CAFile, so fold the kubeconfig's CA file into CAData first
+		if len(config.CAData) == 0 && config.CAFile != "" {
+			caData, err := os.ReadFile(config.CAFile)
+			if err != nil {
+				return fmt.Errorf("failed to read cluster CA file: %w", err)
+			}
+			config.CAData = caData
+		}
+		config.CAFile = ""
+
+		caData := append([]byte{}, config.CAData...)
+		if len(caData) > 0 && caData[len(caData)-1] != '\n' {
+			caData = append(caData, '\n')
+		}
+		config.CAData = append(caData, extraCA...)
+	}
+
+	return nil
+}
+
 // Helper that creates a ClientConfig for a specific context.
 // This handles the kubeconfig loading and context switching logic.
 //
 // The function:
-// - Uses the standard kubeconfig loading rules (checks KUBECONFIG env, then ~/.kube/config)
+// - Uses the configured kubeconfig loading rules (explicit path, or KUBECONFIG env, then ~/.kube/config)
 // - Allows overriding the context (empty string means use current context)
 // - Returns a deferred loading config (config is only loaded when actually needed)
 //
 // This separation allows us to centralize kubeconfig handling and makes testing easier.
 func getKubeConfigForContext(k8sContext string) clientcmd.ClientConfig {
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules := NewConfigLoadingRules()
 	configOverrides := &clientcmd.ConfigOverrides{}
 	if k8sContext == "" {
 		configOverrides = nil
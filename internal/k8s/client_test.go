@@ -0,0 +1,142 @@
+package k8s
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: local
+  cluster:
+    server: https://127.0.0.1:6443
+users:
+- name: admin
+  user:
+    token: test
+contexts:
+- name: dev
+  context:
+    cluster: local
+    user: admin
+    namespace: team-a
+- name: ops
+  context:
+    cluster: local
+    user: admin
+`
+
+func TestGetContextNamespace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0o600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	SetKubeconfigPath(path)
+	t.Cleanup(func() { SetKubeconfigPath("") })
+
+	tests := []struct {
+		name      string
+		context   string
+		want      string
+		wantError bool
+	}{
+		{name: "context with namespace", context: "dev", want: "team-a"},
+		{name: "context without namespace", context: "ops", want: ""},
+		{name: "current context", context: "", want: "team-a"},
+		{name: "unknown context", context: "missing", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetContextNamespace(tt.context)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected namespace %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestApplyClientSettings(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetProxyURL("")
+		SetExtraCAFile("")
+		SetUserAgent("mcp-k8s", "dev")
+	})
+
+	dir := t.TempDir()
+	clusterCAFile := filepath.Join(dir, "cluster-ca.pem")
+	extraCAFile := filepath.Join(dir, "extra-ca.pem")
+	if err := os.WriteFile(clusterCAFile, []byte("CLUSTER CA"), 0o600); err != nil {
+		t.Fatalf("failed to write cluster CA: %v", err)
+	}
+	if err := os.WriteFile(extraCAFile, []byte("EXTRA CA\n"), 0o600); err != nil {
+		t.Fatalf("failed to write extra CA: %v", err)
+	}
+
+	if err := SetProxyURL("http://proxy.internal:3128"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	SetExtraCAFile(extraCAFile)
+	SetUserAgent("mcp-k8s", "1.2.3")
+
+	t.Run("merges cluster CA file with extra CA", func(t *testing.T) {
+		config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{CAFile: clusterCAFile}}
+		if err := applyClientSettings(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := string(config.CAData); got != "CLUSTER CA\nEXTRA CA\n" {
+			t.Errorf("unexpected CA data %q", got)
+		}
+		if config.CAFile != "" {
+			t.Errorf("expected CAFile to be cleared, got %q", config.CAFile)
+		}
+		if config.UserAgent != "mcp-k8s/1.2.3" {
+			t.Errorf("expected User-Agent mcp-k8s/1.2.3, got %q", config.UserAgent)
+		}
+
+		proxy, err := config.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "api.cluster"}})
+		if err != nil || proxy == nil || proxy.Host != "proxy.internal:3128" {
+			t.Errorf("expected proxy.internal:3128, got %v (err: %v)", proxy, err)
+		}
+	})
+
+	t.Run("insecure config ignores extra CA", func(t *testing.T) {
+		config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+		if err := applyClientSettings(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(config.CAData) != 0 {
+			t.Errorf("expected no CA data, got %q", config.CAData)
+		}
+	})
+}
+
+func TestSetProxyURL(t *testing.T) {
+	t.Cleanup(func() { _ = SetProxyURL("") })
+
+	for _, rawURL := range []string{"proxy:3128", "://bad"} {
+		if err := SetProxyURL(rawURL); err == nil {
+			t.Errorf("expected error for %q", rawURL)
+		}
+	}
+	if err := SetProxyURL("https://proxy.internal"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+)
+
+// KubeContext represents a Kubernetes context with its associated cluster information
+type KubeContext struct {
+	Name        string `json:"name"`
+	ClusterName string `json:"clusterName"`
+	Server      string `json:"server,omitempty"`
+	IsCurrent   bool   `json:"isCurrent"`
+}
+
+// CurrentKubeContext represents the kubeconfig's current context
+type CurrentKubeContext struct {
+	Name        string `json:"name"`
+	ClusterName string `json:"clusterName"`
+	Namespace   string `json:"namespace,omitempty"`
+}
+
+// ListContexts returns the contexts in the kubeconfig, sorted by name, with their cluster name
+// and API server URL. Shared by the kubeconfig://contexts resource and the list_k8s_contexts tool.
+func ListContexts() ([]KubeContext, error) {
+	// Load kubeconfig using the same rules as our k8s client
+	config, err := NewConfigLoadingRules().Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	contexts := make([]KubeContext, 0, len(config.Contexts))
+	for name, context := range config.Contexts {
+		kubeContext := KubeContext{
+			Name:        name,
+			ClusterName: context.Cluster,
+			IsCurrent:   name == config.CurrentContext,
+		}
+		if cluster, ok := config.Clusters[context.Cluster]; ok {
+			kubeContext.Server = cluster.Server
+		}
+		contexts = append(contexts, kubeContext)
+	}
+
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+
+	return contexts, nil
+}
+
+// GetCurrentContext returns the kubeconfig's current context with its cluster name and default
+// namespace, loaded with the same rules as ListContexts. Used by the kubeconfig://current-context
+// resource.
+func GetCurrentContext() (*CurrentKubeContext, error) {
+	// Load kubeconfig using the same rules as our k8s client
+	config, err := NewConfigLoadingRules().Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	if config.CurrentContext == "" {
+		return nil, fmt.Errorf("kubeconfig has no current context set; use the kubeconfig://contexts resource to list available contexts")
+	}
+
+	currentContext := &CurrentKubeContext{Name: config.CurrentContext}
+	if context, ok := config.Contexts[config.CurrentContext]; ok {
+		currentContext.ClusterName = context.Cluster
+		currentContext.Namespace = context.Namespace
+	}
+	return currentContext, nil
+}
@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestListContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0o600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	SetKubeconfigPath(path)
+	t.Cleanup(func() { SetKubeconfigPath("") })
+
+	got, err := ListContexts()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []KubeContext{
+		{Name: "dev", ClusterName: "local", Server: "https://127.0.0.1:6443", IsCurrent: true},
+		{Name: "ops", ClusterName: "local", Server: "https://127.0.0.1:6443"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestGetCurrentContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0o600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	SetKubeconfigPath(path)
+	t.Cleanup(func() { SetKubeconfigPath("") })
+
+	got, err := GetCurrentContext()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &CurrentKubeContext{Name: "dev", ClusterName: "local", Namespace: "team-a"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ClassifyClusterError turns low-level failures talking to a cluster into targeted guidance for
+// the common causes: a failing exec credential plugin (EKS/GKE/AKS auth), expired or rejected
+// credentials, TLS verification problems, and an unreachable API server. Unrecognized errors
+// are returned unchanged.
+func ClassifyClusterError(err error) error {
+	if err == nil {
+		return nil
+	}
+	errMsg := err.Error()
+
+	switch {
+	case strings.Contains(errMsg, "executable file not found") || (strings.Contains(errMsg, "exec:") && strings.Contains(errMsg, "not found")):
+		return fmt.Errorf("authentication failed: the kubeconfig exec credential plugin is not installed or not on PATH (e.g. aws, gke-gcloud-auth-plugin, kubelogin). "+
+			"Install it or fix the command in the kubeconfig user entry: %w", err)
+	case strings.Contains(errMsg, "getting credentials") || strings.Contains(errMsg, "exec plugin"):
+		return fmt.Errorf("authentication failed: the kubeconfig exec credential plugin returned an error. "+
+			"Re-authenticate with your cloud provider (e.g. aws sso login, gcloud auth login, az login) and retry: %w", err)
+	case apierrors.IsUnauthorized(err) || strings.Contains(errMsg, "token is expired") || strings.Contains(errMsg, "token has expired"):
+		return fmt.Errorf("authentication failed: the credentials for this context were rejected or have expired. "+
+			"Re-authenticate (e.g. refresh the kubeconfig token) and retry: %w", err)
+	case isTLSError(err):
+		return fmt.Errorf("TLS verification failed: the API server certificate is not trusted for this context. "+
+			"Check the cluster's certificate-authority in the kubeconfig, or pass an extra CA bundle with -ca-file if a proxy re-signs traffic: %w", err)
+	case isUnreachableError(err):
+		return fmt.Errorf("cluster unreachable: could not connect to the API server for this context. "+
+			"Check the server address, VPN or network access, and -proxy-url if a proxy is required: %w", err)
+	}
+
+	return err
+}
+
+// isTLSError reports whether err comes from certificate verification
+func isTLSError(err error) bool {
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalid) {
+		return true
+	}
+
+	errMsg := err.Error()
+	return strings.Contains(errMsg, "x509:") || strings.Contains(errMsg, "tls:")
+}
+
+// isUnreachableError reports whether err means the API server couldn't be reached at all
+func isUnreachableError(err error) bool {
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || errors.As(err, &opErr) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	errMsg := err.Error()
+	return strings.Contains(errMsg, "connection refused") || strings.Contains(errMsg, "no such host") ||
+		strings.Contains(errMsg, "i/o timeout") || strings.Contains(errMsg, "no route to host")
+}
@@ -0,0 +1,80 @@
+package k8s
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestClassifyClusterError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantMsg string // empty means the error is returned unchanged
+	}{
+		{
+			name:    "exec plugin missing",
+			err:     errors.New(`Get "https://example.eks.amazonaws.com/api": getting credentials: exec: executable aws not found`),
+			wantMsg: "not installed or not on PATH",
+		},
+		{
+			name:    "exec plugin failure",
+			err:     errors.New(`Get "https://example.eks.amazonaws.com/api": getting credentials: exec: executable aws failed with exit code 255`),
+			wantMsg: "exec credential plugin returned an error",
+		},
+		{
+			name:    "unauthorized",
+			err:     apierrors.NewUnauthorized("Unauthorized"),
+			wantMsg: "rejected or have expired",
+		},
+		{
+			name:    "unknown authority",
+			err:     &url.Error{Op: "Get", URL: "https://10.0.0.1/api", Err: x509.UnknownAuthorityError{}},
+			wantMsg: "TLS verification failed",
+		},
+		{
+			name:    "connection refused",
+			err:     &url.Error{Op: "Get", URL: "https://10.0.0.1/api", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}},
+			wantMsg: "cluster unreachable",
+		},
+		{
+			name:    "dns failure",
+			err:     fmt.Errorf("discovery: %w", &net.DNSError{Err: "no such host", Name: "api.example.com"}),
+			wantMsg: "cluster unreachable",
+		},
+		{
+			name:    "timeout",
+			err:     fmt.Errorf("discovery: %w", context.DeadlineExceeded),
+			wantMsg: "cluster unreachable",
+		},
+		{
+			name: "unrecognized",
+			err:  errors.New("something else went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ClassifyClusterError(tt.err)
+			if tt.wantMsg == "" {
+				if got != tt.err {
+					t.Errorf("expected error to be unchanged, got %v", got)
+				}
+				return
+			}
+			if !strings.Contains(got.Error(), tt.wantMsg) {
+				t.Errorf("expected error containing %q, got %q", tt.wantMsg, got.Error())
+			}
+			if !errors.Is(got, tt.err) {
+				t.Error("expected classified error to wrap the original")
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package k8s
+
+import (
+	"log/slog"
+	"sync/atomic"
+
+	"k8s.io/client-go/tools/metrics"
+)
+
+// execPluginCalls counts exec credential plugin invocations since startup
+var execPluginCalls atomic.Int64
+
+// execPluginCallLogger receives client-go's exec plugin call metric and logs each invocation
+type execPluginCallLogger struct{}
+
+func (execPluginCallLogger) Increment(exitCode int, callStatus string) {
+	calls := execPluginCalls.Add(1)
+	slog.Debug("exec credential plugin invoked", "exitCode", exitCode, "status", callStatus, "totalCalls", calls)
+}
+
+// EnableExecPluginLogging logs every exec credential plugin invocation (e.g. aws eks get-token,
+// gke-gcloud-auth-plugin, kubelogin) at debug level so authentication overhead is visible.
+//
+// client-go caches exec authenticators by their exec and cluster config, so clients built for the
+// same context reuse the issued credential and the plugin only runs again when it expires or is
+// rejected. Frequent log lines therefore point at short-lived credentials rather than client churn.
+//
+// It should be called once at startup; client-go only accepts the first metrics registration.
+func EnableExecPluginLogging() {
+	metrics.Register(metrics.RegisterOpts{ExecPluginCalls: execPluginCallLogger{}})
+}
@@ -0,0 +1,102 @@
+package k8s
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/plugin/pkg/client/auth/exec"
+	"k8s.io/client-go/rest"
+)
+
+const testExecKubeconfig = `apiVersion: v1
+kind: Config
+current-context: eks
+clusters:
+- name: eks
+  cluster:
+    server: https://eks.example.com
+users:
+- name: eks
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args: [eks, get-token, --cluster-name, test]
+      provideClusterInfo: true
+      interactiveMode: Never
+contexts:
+- name: eks
+  context:
+    cluster: eks
+    user: eks
+`
+
+// Clients are rebuilt for every tool call, so the REST config must map to the same cached exec
+// authenticator each time or the plugin would run on every call
+func TestExecAuthenticatorIsReusedAcrossClients(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config")
+	if err := os.WriteFile(path, []byte(testExecKubeconfig), 0o600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	extraCAFile := filepath.Join(dir, "extra-ca.pem")
+	if err := os.WriteFile(extraCAFile, []byte("EXTRA CA\n"), 0o600); err != nil {
+		t.Fatalf("failed to write extra CA: %v", err)
+	}
+
+	// Server-wide settings are applied to every config and feed into the cluster info the cache is keyed on
+	SetKubeconfigPath(path)
+	SetExtraCAFile(extraCAFile)
+	if err := SetProxyURL("http://proxy.internal:3128"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() {
+		SetKubeconfigPath("")
+		SetExtraCAFile("")
+		_ = SetProxyURL("")
+	})
+
+	authenticator := func() *exec.Authenticator {
+		t.Helper()
+		config, err := getRESTConfigForContext("eks")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if config.ExecProvider == nil {
+			t.Fatal("expected exec provider")
+		}
+		cluster, err := rest.ConfigToExecCluster(config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		a, err := exec.GetAuthenticator(config.ExecProvider, cluster)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return a
+	}
+
+	if first, second := authenticator(), authenticator(); first != second {
+		t.Error("expected clients for the same context to share the cached exec authenticator")
+	}
+}
+
+func TestExecPluginCallLogger(t *testing.T) {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	execPluginCallLogger{}.Increment(1, "plugin_execution_error")
+
+	got := buf.String()
+	for _, want := range []string{"exec credential plugin invoked", "exitCode=1", "status=plugin_execution_error", "totalCalls="} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected log to contain %q, got %q", want, got)
+		}
+	}
+}
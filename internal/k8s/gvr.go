@@ -2,8 +2,14 @@ package k8s
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/restmapper"
 )
 
 // GVKToGVR converts a GroupVersionKind to a GroupVersionResource using the Kubernetes REST mapper.
@@ -13,7 +19,8 @@ import (
 //
 // Parameters:
 //   - context: The kubeconfig context to use for the REST mapper discovery
-//   - gvk: The GroupVersionKind to convert (e.g., {Group: "", Version: "v1", Kind: "Pod"})
+//   - gvk: The GroupVersionKind to convert (e.g., {Group: "", Version: "v1", Kind: "Pod"}).
+//     The version and, for the core group, the group may be omitted; see GVKToRESTMapping.
 //
 // Returns:
 //   - The corresponding GroupVersionResource (e.g., {Group: "", Version: "v1", Resource: "pods"})
@@ -24,17 +31,173 @@ import (
 //	gvr, err := GVKToGVR("production", schema.GroupVersionKind{Version: "v1", Kind: "pod"})
 //	// Returns: {Group: "", Version: "v1", Resource: "pods"}
 func GVKToGVR(context string, gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	mapping, err := GVKToRESTMapping(context, gvk)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+
+	return mapping.Resource, nil
+}
+
+// GVKToRESTMapping returns the full REST mapping for a GroupVersionKind, including the
+// resource and its scope (namespaced or cluster-scoped). The mapping's GroupVersionKind is the
+// one actually served, which may differ from gvk when the preferred version fallback applies.
+func GVKToRESTMapping(context string, gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
 	// Get K8s clients including REST mapper
 	clients, err := getClientsForContext(context)
 	if err != nil {
-		return schema.GroupVersionResource{}, fmt.Errorf("failed to create k8s clients: %w", err)
+		return nil, fmt.Errorf("failed to create k8s clients: %w", err)
 	}
 
 	// Map Kind to Resource using REST mapper
-	mapping, err := clients.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	mapping, err := restMappingWithFallback(clients.restMapper, gvk)
 	if err != nil {
-		return schema.GroupVersionResource{}, fmt.Errorf("failed to map kind to resource: %w", err)
+		return nil, enhanceMappingError(gvk, err)
 	}
 
-	return mapping.Resource, nil
+	return mapping, nil
+}
+
+// restMappingWithFallback maps a kind to its resource, falling back to the cluster's preferred
+// version when no version was given or the given one isn't served, so clients don't need to know
+// exact versions. A kind given without a group that isn't in the core group is looked up across
+// all groups (e.g. "Ingress" resolves to networking.k8s.io).
+func restMappingWithFallback(restMapper meta.RESTMapper, gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	var versions []string
+	if gvk.Version != "" {
+		versions = append(versions, gvk.Version)
+	}
+
+	mapping, err := restMapper.RESTMapping(gvk.GroupKind(), versions...)
+	if err == nil || !meta.IsNoMatchError(err) {
+		return mapping, err
+	}
+
+	// Use the preferred version of the kind in the same group
+	if gvk.Version != "" {
+		if preferred, preferredErr := restMapper.RESTMapping(gvk.GroupKind()); preferredErr == nil {
+			return preferred, nil
+		}
+	}
+
+	// Find the kind in another group by its singular resource name
+	if gvk.Group == "" {
+		resolved, kindErr := restMapper.KindFor(schema.GroupVersionResource{Resource: strings.ToLower(gvk.Kind)})
+		if kindErr == nil {
+			if preferred, preferredErr := restMapper.RESTMapping(resolved.GroupKind()); preferredErr == nil {
+				return preferred, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+// enhanceMappingError wraps "no matches for kind" errors with guidance about discovering valid resource types
+func enhanceMappingError(gvk schema.GroupVersionKind, err error) error {
+	if meta.IsNoMatchError(err) {
+		group := gvk.Group
+		if group == "" {
+			group = "core"
+		}
+		return fmt.Errorf("resource type not found: kind %q does not exist in group %q version %q on this cluster. "+
+			"Check the kind, group, and version, use the resolve_k8s_kind tool to find the canonical group/version, or use the list_k8s_api_resources tool to discover available resource types: %w",
+			gvk.Kind, group, gvk.Version, err)
+	}
+
+	return fmt.Errorf("failed to map kind to resource: %w", err)
+}
+
+// ResolvedKind is a canonical resource type matching a kind, resource name, or short name
+type ResolvedKind struct {
+	Group            string   `json:"group"`
+	Version          string   `json:"version"`
+	Kind             string   `json:"kind"`
+	Resource         string   `json:"resource"`
+	Namespaced       bool     `json:"namespaced"`
+	PreferredVersion string   `json:"preferredVersion"`
+	Versions         []string `json:"versions"`
+}
+
+// ResolveKind finds the resource types matching a name, which may be a Kind ("Deployment"), a
+// plural or singular resource name ("deployments"), or a short name ("deploy"), matched
+// case-insensitively. Group and version narrow the results when set; without a version each
+// match uses the group's preferred version. Core group matches sort first.
+func ResolveKind(context, name, group, version string) ([]ResolvedKind, error) {
+	discoveryClient, err := GetDiscoveryClientForContext(context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	groupResources, err := restmapper.GetAPIGroupResources(discoveryClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover API resources: %w", ClassifyClusterError(err))
+	}
+
+	return resolveKindFromGroupResources(groupResources, name, group, version), nil
+}
+
+func resolveKindFromGroupResources(groupResources []*restmapper.APIGroupResources, name, group, version string) []ResolvedKind {
+	var matches []ResolvedKind
+	for _, apiGroup := range groupResources {
+		if group != "" && !strings.EqualFold(apiGroup.Group.Name, group) {
+			continue
+		}
+
+		// Collect the versions serving a matching resource, in the group's priority order
+		var resolved *ResolvedKind
+		for _, groupVersion := range apiGroup.Group.Versions {
+			for _, resource := range apiGroup.VersionedResources[groupVersion.Version] {
+				if strings.Contains(resource.Name, "/") || !resourceMatchesName(resource, name) {
+					continue
+				}
+				if resolved == nil {
+					resolved = &ResolvedKind{
+						Group:            apiGroup.Group.Name,
+						Version:          groupVersion.Version,
+						Kind:             resource.Kind,
+						Resource:         resource.Name,
+						Namespaced:       resource.Namespaced,
+						PreferredVersion: apiGroup.Group.PreferredVersion.Version,
+					}
+				}
+				resolved.Versions = append(resolved.Versions, groupVersion.Version)
+				break
+			}
+		}
+		if resolved == nil {
+			continue
+		}
+
+		// Use the requested version, or the preferred version when it serves the resource
+		switch {
+		case version != "":
+			if !slices.Contains(resolved.Versions, version) {
+				continue
+			}
+			resolved.Version = version
+		case slices.Contains(resolved.Versions, resolved.PreferredVersion):
+			resolved.Version = resolved.PreferredVersion
+		}
+		matches = append(matches, *resolved)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Group == "" && matches[j].Group != ""
+	})
+	return matches
+}
+
+// resourceMatchesName reports whether a name refers to an API resource by kind, plural,
+// singular, or short name
+func resourceMatchesName(resource metav1.APIResource, name string) bool {
+	if strings.EqualFold(resource.Kind, name) || strings.EqualFold(resource.Name, name) || strings.EqualFold(resource.SingularName, name) {
+		return true
+	}
+	for _, shortName := range resource.ShortNames {
+		if strings.EqualFold(shortName, name) {
+			return true
+		}
+	}
+	return false
 }
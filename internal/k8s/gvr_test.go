@@ -0,0 +1,207 @@
+package k8s
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/restmapper"
+)
+
+func TestEnhanceMappingError(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Widget"}
+
+	noMatchErr := &meta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+	err := enhanceMappingError(gvk, noMatchErr)
+	if !strings.Contains(err.Error(), "list_k8s_api_resources") {
+		t.Errorf("expected guidance about list_k8s_api_resources, got %q", err.Error())
+	}
+	if !strings.Contains(err.Error(), `group "core"`) {
+		t.Errorf("expected core group to be named, got %q", err.Error())
+	}
+	if !meta.IsNoMatchError(err) {
+		t.Errorf("expected wrapped error to remain a no-match error")
+	}
+
+	otherErr := errors.New("connection refused")
+	err = enhanceMappingError(gvk, otherErr)
+	if strings.Contains(err.Error(), "list_k8s_api_resources") {
+		t.Errorf("unexpected guidance for unrelated error: %q", err.Error())
+	}
+	if !errors.Is(err, otherErr) {
+		t.Errorf("expected original error to be wrapped")
+	}
+}
+
+func TestResolveKindFromGroupResources(t *testing.T) {
+	deployment := metav1.APIResource{Name: "deployments", SingularName: "deployment", Kind: "Deployment", Namespaced: true, ShortNames: []string{"deploy"}}
+	hpa := metav1.APIResource{Name: "horizontalpodautoscalers", SingularName: "horizontalpodautoscaler", Kind: "HorizontalPodAutoscaler", Namespaced: true, ShortNames: []string{"hpa"}}
+	groupResources := []*restmapper.APIGroupResources{
+		{
+			Group: metav1.APIGroup{
+				Name:             "",
+				Versions:         []metav1.GroupVersionForDiscovery{{Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{Version: "v1"},
+			},
+			VersionedResources: map[string][]metav1.APIResource{
+				"v1": {
+					{Name: "nodes", SingularName: "node", Kind: "Node", ShortNames: []string{"no"}},
+					{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}},
+					{Name: "pods/log", Kind: "Pod", Namespaced: true},
+				},
+			},
+		},
+		{
+			Group: metav1.APIGroup{
+				Name:             "apps",
+				Versions:         []metav1.GroupVersionForDiscovery{{Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{Version: "v1"},
+			},
+			VersionedResources: map[string][]metav1.APIResource{"v1": {deployment}},
+		},
+		{
+			Group: metav1.APIGroup{
+				Name:             "autoscaling",
+				Versions:         []metav1.GroupVersionForDiscovery{{Version: "v2"}, {Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{Version: "v2"},
+			},
+			VersionedResources: map[string][]metav1.APIResource{"v2": {hpa}, "v1": {hpa}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		group   string
+		version string
+		want    []ResolvedKind
+	}{
+		{
+			name:  "kind",
+			query: "deployment",
+			want:  []ResolvedKind{{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespaced: true, PreferredVersion: "v1", Versions: []string{"v1"}}},
+		},
+		{
+			name:  "short name",
+			query: "no",
+			want:  []ResolvedKind{{Version: "v1", Kind: "Node", Resource: "nodes", PreferredVersion: "v1", Versions: []string{"v1"}}},
+		},
+		{
+			name:  "preferred version",
+			query: "hpa",
+			want:  []ResolvedKind{{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler", Resource: "horizontalpodautoscalers", Namespaced: true, PreferredVersion: "v2", Versions: []string{"v2", "v1"}}},
+		},
+		{
+			name:    "requested version",
+			query:   "HorizontalPodAutoscaler",
+			version: "v1",
+			want:    []ResolvedKind{{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler", Resource: "horizontalpodautoscalers", Namespaced: true, PreferredVersion: "v2", Versions: []string{"v2", "v1"}}},
+		},
+		{name: "wrong group", query: "Deployment", group: "extensions"},
+		{name: "unserved version", query: "Deployment", version: "v1beta1"},
+		{name: "subresources skipped", query: "pods/log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveKindFromGroupResources(groupResources, tt.query, tt.group, tt.version)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRestMappingWithFallback(t *testing.T) {
+	hpa := metav1.APIResource{Name: "horizontalpodautoscalers", SingularName: "horizontalpodautoscaler", Kind: "HorizontalPodAutoscaler", Namespaced: true}
+	restMapper := restmapper.NewDiscoveryRESTMapper([]*restmapper.APIGroupResources{
+		{
+			Group: metav1.APIGroup{
+				Name:             "",
+				Versions:         []metav1.GroupVersionForDiscovery{{Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{Version: "v1"},
+			},
+			VersionedResources: map[string][]metav1.APIResource{
+				"v1": {{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true}},
+			},
+		},
+		{
+			Group: metav1.APIGroup{
+				Name:             "autoscaling",
+				Versions:         []metav1.GroupVersionForDiscovery{{Version: "v2"}, {Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{Version: "v2"},
+			},
+			VersionedResources: map[string][]metav1.APIResource{"v2": {hpa}, "v1": {hpa}},
+		},
+		{
+			Group: metav1.APIGroup{
+				Name:             "networking.k8s.io",
+				Versions:         []metav1.GroupVersionForDiscovery{{Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{Version: "v1"},
+			},
+			VersionedResources: map[string][]metav1.APIResource{
+				"v1": {{Name: "ingresses", SingularName: "ingress", Kind: "Ingress", Namespaced: true}},
+			},
+		},
+	})
+
+	tests := []struct {
+		name    string
+		gvk     schema.GroupVersionKind
+		want    schema.GroupVersionResource
+		wantErr bool
+	}{
+		{
+			name: "exact version",
+			gvk:  schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"},
+			want: schema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"},
+		},
+		{
+			name: "no version uses preferred",
+			gvk:  schema.GroupVersionKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"},
+			want: schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+		},
+		{
+			name: "unserved version falls back to preferred",
+			gvk:  schema.GroupVersionKind{Group: "autoscaling", Version: "v2beta2", Kind: "HorizontalPodAutoscaler"},
+			want: schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+		},
+		{
+			name: "core kind without version",
+			gvk:  schema.GroupVersionKind{Kind: "Pod"},
+			want: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		},
+		{
+			name: "kind without group found in another group",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "Ingress"},
+			want: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+		},
+		{
+			name:    "unknown kind",
+			gvk:     schema.GroupVersionKind{Version: "v1", Kind: "Widget"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping, err := restMappingWithFallback(restMapper, tt.gvk)
+			if tt.wantErr {
+				if !meta.IsNoMatchError(err) {
+					t.Fatalf("expected no-match error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mapping.Resource != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, mapping.Resource)
+			}
+		})
+	}
+}
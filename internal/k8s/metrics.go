@@ -0,0 +1,35 @@
+package k8s
+
+import (
+	"k8s.io/client-go/discovery"
+)
+
+// MetricsAPIGroup is the API group served by metrics-server
+const MetricsAPIGroup = "metrics.k8s.io"
+
+// IsMetricsAPIAvailable reports whether the metrics.k8s.io API group is registered on the
+// cluster for the given context. This distinguishes a cluster without metrics-server from
+// a transient failure when fetching metrics.
+func IsMetricsAPIAvailable(k8sContext string) (bool, error) {
+	discoveryClient, err := GetDiscoveryClientForContext(k8sContext)
+	if err != nil {
+		return false, err
+	}
+
+	return hasAPIGroup(discoveryClient, MetricsAPIGroup)
+}
+
+// hasAPIGroup checks discovery for a registered API group
+func hasAPIGroup(discoveryClient discovery.DiscoveryInterface, group string) (bool, error) {
+	groups, err := discoveryClient.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+
+	for _, g := range groups.Groups {
+		if g.Name == group {
+			return true, nil
+		}
+	}
+	return false, nil
+}
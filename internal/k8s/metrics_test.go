@@ -0,0 +1,48 @@
+package k8s
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestHasAPIGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []*metav1.APIResourceList
+		want      bool
+	}{
+		{
+			name: "metrics API registered",
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: "v1"},
+				{GroupVersion: "metrics.k8s.io/v1beta1"},
+			},
+			want: true,
+		},
+		{
+			name: "metrics API missing",
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: "v1"},
+				{GroupVersion: "apps/v1"},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: tt.resources}}
+
+			got, err := hasAPIGroup(discoveryClient, MetricsAPIGroup)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package k8s
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// HasSubresource reports whether the resource type serves the given subresource (e.g. "scale"),
+// so callers can tell an unsupported subresource apart from a missing object
+func HasSubresource(k8sContext string, gvr schema.GroupVersionResource, subresource string) (bool, error) {
+	discoveryClient, err := GetDiscoveryClientForContext(k8sContext)
+	if err != nil {
+		return false, err
+	}
+
+	return hasSubresource(discoveryClient, gvr, subresource)
+}
+
+func hasSubresource(discoveryClient discovery.DiscoveryInterface, gvr schema.GroupVersionResource, subresource string) (bool, error) {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		return false, fmt.Errorf("failed to discover resources for %s: %w", gvr.GroupVersion(), err)
+	}
+
+	want := gvr.Resource + "/" + subresource
+	for _, resource := range resources.APIResources {
+		if resource.Name == want {
+			return true, nil
+		}
+	}
+	return false, nil
+}
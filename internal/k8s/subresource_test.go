@@ -0,0 +1,48 @@
+package k8s
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestHasSubresource(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true},
+				{Name: "deployments/scale", Kind: "Scale", Namespaced: true},
+				{Name: "deployments/status", Kind: "Deployment", Namespaced: true},
+				{Name: "daemonsets", Kind: "DaemonSet", Namespaced: true},
+				{Name: "daemonsets/status", Kind: "DaemonSet", Namespaced: true},
+			},
+		},
+	}}}
+
+	tests := []struct {
+		name     string
+		resource string
+		want     bool
+	}{
+		{name: "scalable", resource: "deployments", want: true},
+		{name: "not scalable", resource: "daemonsets", want: false},
+		{name: "unknown resource", resource: "widgets", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: tt.resource}
+			got, err := hasSubresource(discoveryClient, gvr, "scale")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func RegisterCertificateExpiryMCPPrompt(s *server.MCPServer) {
+	addPrompt(s, newCertificateExpiryMCPPrompt(), certificateExpiryHandler)
+}
+
+// Prompt schema
+func newCertificateExpiryMCPPrompt() mcp.Prompt {
+	return mcp.NewPrompt("certificate_expiry_analysis",
+		mcp.WithPromptDescription("Analyze cert-manager Certificates for upcoming expiry and readiness problems. Requires a Kubernetes context to be specified."),
+		mcp.WithArgument("context",
+			mcp.ArgumentDescription("The Kubernetes context to use for the analysis"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("namespace",
+			mcp.ArgumentDescription("The namespace to analyze (optional, defaults to all namespaces)"),
+		),
+	)
+}
+
+// Prompt handler
+func certificateExpiryHandler(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	// Extract the required context argument
+	k8sContext := request.Params.Arguments["context"]
+	if k8sContext == "" {
+		return nil, fmt.Errorf("context argument is required")
+	}
+
+	// Extract the optional namespace argument
+	namespace := request.Params.Arguments["namespace"]
+
+	// Build the analysis scope description and tool filter
+	var scopeDescription, namespaceFilter string
+	if namespace != "" {
+		scopeDescription = fmt.Sprintf("Analyze namespace: %s", namespace)
+		namespaceFilter = fmt.Sprintf("\n   - namespace: %s", namespace)
+	} else {
+		scopeDescription = "Analyze all namespaces"
+		namespaceFilter = "\n   - allNamespaces: true"
+	}
+
+	// Build the prompt content with the specified context and namespace
+	promptContent := fmt.Sprintf(`Analyze cert-manager Certificates for expiry and readiness issues. Check for:
+1. Certificates that have already expired
+2. Certificates expiring soon
+3. Certificates that are not Ready
+
+Use Kubernetes context: %s
+%s
+
+<instructions>
+1. Use the list_k8s_resources tool to get Certificates:
+   - context: %s
+   - group: cert-manager.io
+   - version: v1
+   - kind: Certificate%s
+   The Certificate output includes ready, reason, message, notAfter, expiresIn, expired and renewalTime.
+   If the Certificate kind is not found, cert-manager is not installed; report that and stop.
+2. For Certificates that are not Ready or are close to expiry:
+   - Use list_k8s_resources with kind: Event and fieldSelector: involvedObject.name=<certificate name> to find related events
+   - Use list_k8s_resources with group: cert-manager.io, version: v1, kind: CertificateRequest to check for failed issuance attempts
+3. Summarize findings ordered by time to expiry:
+   - CRITICAL: Expired certificates, or not Ready and expiring within 7 days
+   - HIGH: Expiring within 14 days, or renewalTime has passed without renewal
+   - MEDIUM: Not Ready certificates with a valid existing certificate
+   - LOW: Healthy certificates expiring within 30 days
+4. Include a table showing certificate name, namespace, DNS names, issuer, ready status and time to expiry
+5. Provide recommendations for each issue (e.g. check issuer configuration, DNS/HTTP challenge failures, rate limits)
+</instructions>`, k8sContext, scopeDescription, k8sContext, namespaceFilter)
+
+	return &mcp.GetPromptResult{
+		Description: "Certificate expiry analysis prompt",
+		Messages: []mcp.PromptMessage{
+			{
+				Role:    "user",
+				Content: mcp.NewTextContent(promptContent),
+			},
+		},
+	}, nil
+}
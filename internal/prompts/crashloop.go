@@ -0,0 +1,94 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func RegisterCrashloopMCPPrompt(s *server.MCPServer) {
+	addPrompt(s, newCrashloopMCPPrompt(), crashloopHandler)
+}
+
+// Prompt schema
+func newCrashloopMCPPrompt() mcp.Prompt {
+	return mcp.NewPrompt("crashloop_analysis",
+		mcp.WithPromptDescription("Identify crash-looping and frequently restarting containers and determine their likely root causes from previous container logs. Requires a Kubernetes context to be specified."),
+		mcp.WithArgument("context",
+			mcp.ArgumentDescription("The Kubernetes context to use for the analysis"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("namespace",
+			mcp.ArgumentDescription("The namespace to analyze (optional, defaults to all namespaces)"),
+		),
+	)
+}
+
+// Prompt handler
+func crashloopHandler(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	// Extract the required context argument
+	k8sContext := request.Params.Arguments["context"]
+	if k8sContext == "" {
+		return nil, fmt.Errorf("context argument is required")
+	}
+
+	// Extract the optional namespace argument
+	namespace := request.Params.Arguments["namespace"]
+
+	// Build the analysis scope description and tool filter
+	var scopeDescription, namespaceFilter string
+	if namespace != "" {
+		scopeDescription = fmt.Sprintf("Analyze namespace: %s", namespace)
+		namespaceFilter = fmt.Sprintf("\n   - namespace: %s", namespace)
+	} else {
+		scopeDescription = "Analyze all namespaces"
+	}
+
+	// Build the prompt content with the specified context and namespace
+	promptContent := fmt.Sprintf(`Analyze pods for crash-looping and frequently restarting containers. Check for:
+1. Containers with high restart counts
+2. Containers that were OOM killed
+3. Containers terminating with errors (Error, OOMKilled, ContainerCannotRun, etc.)
+
+Use Kubernetes context: %s
+%s
+
+<instructions>
+1. Use the list_k8s_restarting_pods tool to get only the pods that have restarted, already ranked by restart count, then OOM kills:
+   - context: %s%s
+   - window: 24h (only pods whose most recent restart was in the last 24 hours; use 0 to include older restarts)
+   Each pod includes restarts, oomKills, lastTerminationReason, lastRestartAt, status, owner (e.g. ReplicaSet/web-5d4f8),
+   and notReadyContainers with each non-ready container's current state (e.g. "Waiting: CrashLoopBackOff").
+2. Identify the worst offenders from that list: the pods with the most restarts, oomKills > 0, a lastTerminationReason
+   other than Completed, or a not-ready container waiting with CrashLoopBackOff.
+3. For each of the top offenders (perform in parallel when possible):
+   - Use get_k8s_resource to fetch the pod and identify which containers are restarting
+   - Use get_k8s_pod_logs with previous=true, includeCurrent=true and tail=50 to read the logs from the crashed container instance
+     together with the current instance in one call
+   - If previous logs are unavailable, fall back to current logs with sinceLastRestart=true to read only the current run
+4. Use list_k8s_resources with kind: Event and fieldSelector: involvedObject.name=<pod name> to find related events
+   (BackOff, Unhealthy probe failures, FailedMount, etc.)
+5. Determine the likely root cause for each offender, such as:
+   - Memory limits too low (OOMKilled)
+   - Application startup failures or missing configuration
+   - Failing liveness probes
+   - Unavailable dependencies (databases, services, DNS)
+6. Produce a ranked list of the worst offenders showing:
+   - Pod name, namespace and container, and the owning workload (follow a ReplicaSet owner to its Deployment with list_k8s_resources kind: ReplicaSet, whose owner field names the Deployment)
+   - Restart count, OOM kills and last termination reason
+   - Key log lines from the previous instance
+   - Likely root cause and recommended fix
+</instructions>`, k8sContext, scopeDescription, k8sContext, namespaceFilter)
+
+	return &mcp.GetPromptResult{
+		Description: "Crash loop analysis prompt",
+		Messages: []mcp.PromptMessage{
+			{
+				Role:    "user",
+				Content: mcp.NewTextContent(promptContent),
+			},
+		},
+	}, nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func RegisterMemoryPressureMCPPrompt(s *server.MCPServer) {
-	s.AddPrompt(newMemoryPressureMCPPrompt(), memoryPressureHandler)
+	addPrompt(s, newMemoryPressureMCPPrompt(), memoryPressureHandler)
 }
 
 // Prompt schema
@@ -23,6 +23,9 @@ func newMemoryPressureMCPPrompt() mcp.Prompt {
 		mcp.WithArgument("namespace",
 			mcp.ArgumentDescription("The namespace to analyze (optional, defaults to all namespaces)"),
 		),
+		mcp.WithArgument("labelSelector",
+			mcp.ArgumentDescription("Label selector to narrow the analysis to specific workloads, e.g. 'app=web' (optional)"),
+		),
 	)
 }
 
@@ -42,7 +45,14 @@ func memoryPressureHandler(ctx context.Context, request mcp.GetPromptRequest) (*
 	if namespace != "" {
 		scopeDescription = fmt.Sprintf("Analyze namespace: %s", namespace)
 	} else {
-		scopeDescription = "Analyze all namespaces"
+		scopeDescription = "Analyze all namespaces (pass allNamespaces: true to the tools)"
+	}
+
+	// Narrow the scope and tool calls to the optional label selector
+	var selectorInstruction string
+	if labelSelector := request.Params.Arguments["labelSelector"]; labelSelector != "" {
+		scopeDescription += fmt.Sprintf("\nOnly analyze pods matching label selector: %s", labelSelector)
+		selectorInstruction = fmt.Sprintf("\n   Pass labelSelector: %s to all three tools to limit results to matching pods.", labelSelector)
 	}
 
 	// Build the prompt content with the specified context and namespace
@@ -58,19 +68,25 @@ First, fetch pod metrics to analyze memory usage patterns.
 
 <instructions>
 1. Use the get_k8s_metrics tool to fetch current memory usage
-2. Use the list_k8s_resources tool to get pod resource limits and requests
-3. Look for pods where:
+2. Use the compare_k8s_pod_metrics tool with an interval (e.g. '2m') to see whether usage is climbing rather than
+   judging from one snapshot. It returns the memory change per pod and container and flags potentialLeak on pods
+   whose memory grew with no container shrinking
+3. Use the list_k8s_resources tool to get pod resource limits and requests%s
+4. Look for pods where:
    - Memory usage is >80%% of the memory limit (high risk of OOM)
    - Memory usage is >120%% of the memory request (may cause node pressure)
    - Container status shows OOMKilled as a reason for termination
-4. Summarize findings in a table showing:
+   - compare_k8s_pod_metrics flags potentialLeak (a possible leak that will eventually hit the limit; for
+     confirmation, get_k8s_metrics with samples and interval shows whether growth is steady)
+5. Summarize findings in a table showing:
    - Pod name and namespace
    - Memory usage (current/request/limit)
    - Usage percentage of limit
    - Usage percentage of request
+   - Memory growth over the compared interval
    - OOM kill history if any
-5. Highlight critical issues and provide recommendations
-</instructions>`, k8sContext, scopeDescription)
+6. Highlight critical issues and provide recommendations
+</instructions>`, k8sContext, scopeDescription, selectorInstruction)
 
 	return &mcp.GetPromptResult{
 		Description: "Memory pressure analysis prompt",
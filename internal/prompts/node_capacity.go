@@ -0,0 +1,97 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func RegisterNodeCapacityMCPPrompt(s *server.MCPServer) {
+	addPrompt(s, newNodeCapacityMCPPrompt(), nodeCapacityHandler)
+}
+
+// Prompt schema
+func newNodeCapacityMCPPrompt() mcp.Prompt {
+	return mcp.NewPrompt("node_capacity_analysis",
+		mcp.WithPromptDescription("Analyze nodes for CPU and memory saturation and identify pods at risk of eviction. Requires a Kubernetes context to be specified."),
+		mcp.WithArgument("context",
+			mcp.ArgumentDescription("The Kubernetes context to use for the analysis"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("node",
+			mcp.ArgumentDescription("The node name to analyze (optional, defaults to all nodes)"),
+		),
+	)
+}
+
+// Prompt handler
+func nodeCapacityHandler(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	// Extract the required context argument
+	k8sContext := request.Params.Arguments["context"]
+	if k8sContext == "" {
+		return nil, fmt.Errorf("context argument is required")
+	}
+
+	// Extract the optional node argument
+	node := request.Params.Arguments["node"]
+
+	// Build the analysis scope description and tool filters
+	var scopeDescription, nameFilter, podFilter string
+	if node != "" {
+		scopeDescription = fmt.Sprintf("Analyze node: %s", node)
+		nameFilter = fmt.Sprintf("\n   - name: %s", node)
+		podFilter = fmt.Sprintf("\n   - allNamespaces: true\n   - fieldSelector: spec.nodeName=%s", node)
+	} else {
+		scopeDescription = "Analyze all nodes"
+		podFilter = "\n   - allNamespaces: true\n   - fieldSelector: spec.nodeName=<node name>"
+	}
+
+	// Build the prompt content with the specified context and node
+	promptContent := fmt.Sprintf(`Analyze nodes for CPU and memory capacity issues. Check for:
+1. Nodes with CPU or memory usage approaching their allocatable capacity
+2. Nodes reporting NotReady status
+3. Pods at risk of eviction on saturated nodes
+
+Use Kubernetes context: %s
+%s
+
+<instructions>
+1. Use the get_k8s_metrics tool to fetch current node usage:
+   - context: %s
+   - kind: node%s
+2. Use the list_k8s_resources tool to get node allocatable and capacity:
+   - context: %s
+   - kind: Node
+   The Node output includes cpuAllocatableMillicores, memoryAllocatableMiB, cpuCapacityMillicores, memoryCapacityMiB and podsAllocatable.
+3. For each node calculate:
+   - CPU usage as a percentage of allocatable CPU
+   - Memory usage as a percentage of allocatable memory
+4. Flag nodes where:
+   - Memory usage is >85%% of allocatable (high eviction risk)
+   - CPU usage is >90%% of allocatable (throttling and scheduling pressure)
+   - Status is NotReady
+5. For each flagged node, use the list_k8s_resources tool to find the pods running on it:
+   - context: %s
+   - kind: Pod%s
+   Pods with no memory requests, or with usage well above their requests, are evicted first under memory pressure.
+6. Summarize findings organized by criticality:
+   - CRITICAL: NotReady nodes and nodes above memory eviction thresholds
+   - HIGH: Nodes approaching saturation
+   - MEDIUM: Nodes with uneven load or high pod counts relative to podsAllocatable
+   - LOW: Informational observations
+7. Include a table showing node name, CPU usage/allocatable, memory usage/allocatable and percentages
+8. List pods at risk of eviction and provide recommendations
+</instructions>`, k8sContext, scopeDescription, k8sContext, nameFilter, k8sContext, k8sContext, podFilter)
+
+	return &mcp.GetPromptResult{
+		Description: "Node capacity analysis prompt",
+		Messages: []mcp.PromptMessage{
+			{
+				Role:    "user",
+				Content: mcp.NewTextContent(promptContent),
+			},
+		},
+	}, nil
+}
@@ -3,11 +3,53 @@
 package prompts
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
-func RegisterMCPPrompts(s *server.MCPServer) {
+// disabledPrompts holds prompt names operators asked not to register, mapped to whether a
+// prompt with that name was seen during registration
+var disabledPrompts = map[string]bool{}
+
+// SetDisabledPrompts sets the prompts to skip during registration
+func SetDisabledPrompts(names []string) {
+	disabledPrompts = make(map[string]bool, len(names))
+	for _, name := range names {
+		disabledPrompts[name] = false
+	}
+}
+
+func RegisterMCPPrompts(s *server.MCPServer) error {
 	// Register prompts
 	RegisterMemoryPressureMCPPrompt(s)
 	RegisterWorkloadInstabilityMCPPrompt(s)
+	RegisterNodeCapacityMCPPrompt(s)
+	RegisterCrashloopMCPPrompt(s)
+	RegisterCertificateExpiryMCPPrompt(s)
+
+	// Catch typos so a prompt an operator meant to disable isn't silently exposed
+	var unknown []string
+	for name, seen := range disabledPrompts {
+		if !seen {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("cannot disable unknown prompts: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// addPrompt registers a prompt unless it has been disabled
+func addPrompt(s *server.MCPServer, prompt mcp.Prompt, handler server.PromptHandlerFunc) {
+	if _, disabled := disabledPrompts[prompt.Name]; disabled {
+		disabledPrompts[prompt.Name] = true
+		return
+	}
+	s.AddPrompt(prompt, handler)
 }
@@ -0,0 +1,51 @@
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestRegisterMCPPrompts(t *testing.T) {
+	s := server.NewMCPServer("test", "test", server.WithPromptCapabilities(false))
+	if err := RegisterMCPPrompts(s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	message := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"prompts/list"}`))
+	response, ok := message.(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("unexpected response type %T: %+v", message, message)
+	}
+
+	result, ok := response.Result.(mcp.ListPromptsResult)
+	if !ok {
+		t.Fatalf("unexpected result type %T", response.Result)
+	}
+
+	registered := make(map[string]bool, len(result.Prompts))
+	for _, prompt := range result.Prompts {
+		registered[prompt.Name] = true
+	}
+
+	expectedPrompts := []string{
+		"memory_pressure_analysis",
+		"workload_instability_analysis",
+		"node_capacity_analysis",
+		"crashloop_analysis",
+		"certificate_expiry_analysis",
+	}
+
+	for _, name := range expectedPrompts {
+		if !registered[name] {
+			t.Errorf("Expected prompt %q to be registered", name)
+		}
+	}
+
+	if len(result.Prompts) != len(expectedPrompts) {
+		t.Errorf("Expected %d registered prompts, got %d", len(expectedPrompts), len(result.Prompts))
+	}
+}
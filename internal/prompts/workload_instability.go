@@ -9,7 +9,7 @@ import (
 )
 
 func RegisterWorkloadInstabilityMCPPrompt(s *server.MCPServer) {
-	s.AddPrompt(newWorkloadInstabilityMCPPrompt(), workloadInstabilityHandler)
+	addPrompt(s, newWorkloadInstabilityMCPPrompt(), workloadInstabilityHandler)
 }
 
 // Prompt schema
@@ -24,6 +24,9 @@ func newWorkloadInstabilityMCPPrompt() mcp.Prompt {
 			mcp.ArgumentDescription("The namespace to analyze for workload instability"),
 			mcp.RequiredArgument(),
 		),
+		mcp.WithArgument("labelSelector",
+			mcp.ArgumentDescription("Label selector to narrow the analysis to specific workloads, e.g. 'app=web' (optional)"),
+		),
 	)
 }
 
@@ -41,11 +44,18 @@ func workloadInstabilityHandler(ctx context.Context, request mcp.GetPromptReques
 		return nil, fmt.Errorf("namespace argument is required")
 	}
 
+	// Narrow pod discovery to the optional label selector
+	var scopeDescription, selectorFilter string
+	if labelSelector := request.Params.Arguments["labelSelector"]; labelSelector != "" {
+		scopeDescription = fmt.Sprintf("\nLabel selector: %s (only analyze matching pods and their Events)", labelSelector)
+		selectorFilter = fmt.Sprintf("\n   - labelSelector: %s", labelSelector)
+	}
+
 	// Build the prompt content with the specified context and namespace
 	promptContent := fmt.Sprintf(`Analyze Events and pod logs for signs of workload instability in namespace "%s".
 
 Use Kubernetes context: %s
-Target namespace: %s
+Target namespace: %s%s
 
 <instructions>
 PHASE 1: Event Analysis
@@ -53,6 +63,7 @@ PHASE 1: Event Analysis
    - context: %s
    - namespace: %s
    - kind: Event
+   - aggregate: true (groups repeated events by reason and involved object with summed counts and first/last seen)
    
 2. Analyze Events for suspicious patterns:
    - Warning type events (especially recurring ones)
@@ -66,11 +77,12 @@ PHASE 2: Pod Discovery and Log Analysis
 1. Use list_k8s_resources tool to get all Pods in the namespace:
    - context: %s
    - namespace: %s
-   - kind: Pod
+   - kind: Pod%s
 
 2. For each pod (perform in parallel when possible):
    - Use get_k8s_pod_logs tool with tail=50 for recent logs
    - If multi-container pods, analyze logs from all containers
+   - Use each pod's owner field (e.g. ReplicaSet/web-5d4f8, Job/backup-123) to attribute issues to the owning workload
    - Look for suspicious patterns in logs:
      * ERROR, FATAL, PANIC level messages
      * Authentication/authorization failures
@@ -115,7 +127,7 @@ For each finding, include:
 - Recommended actions where applicable
 
 Focus on actionable insights and avoid including normal operational noise.
-</instructions>`, namespace, k8sContext, namespace, k8sContext, namespace, k8sContext, namespace)
+</instructions>`, namespace, k8sContext, namespace, scopeDescription, k8sContext, namespace, k8sContext, namespace, selectorFilter)
 
 	return &mcp.GetPromptResult{
 		Description: "Workload instability analysis prompt for Kubernetes namespace",
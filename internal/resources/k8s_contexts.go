@@ -7,15 +7,9 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	"k8s.io/client-go/tools/clientcmd"
-)
 
-// KubeContext represents a Kubernetes context with its associated cluster information
-type KubeContext struct {
-	Name        string `json:"name"`
-	ClusterName string `json:"clusterName"`
-	IsCurrent   bool   `json:"isCurrent"`
-}
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
 
 func RegisterK8sContextsMCPResource(s *server.MCPServer) {
 	s.AddResource(newK8sContextsMCPResource(), k8sContextsHandler)
@@ -33,24 +27,9 @@ func newK8sContextsMCPResource() mcp.Resource {
 
 // Resource handler
 func k8sContextsHandler(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
-	// Load kubeconfig using the same rules as our k8s client
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	config, err := loadingRules.Load()
+	contexts, err := k8s.ListContexts()
 	if err != nil {
-		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
-	}
-
-	// Get the current context
-	currentContext := config.CurrentContext
-
-	// Build list of contexts with their cluster names
-	contexts := make([]KubeContext, 0, len(config.Contexts))
-	for name, context := range config.Contexts {
-		contexts = append(contexts, KubeContext{
-			Name:        name,
-			ClusterName: context.Cluster,
-			IsCurrent:   name == currentContext,
-		})
+		return nil, err
 	}
 
 	// Convert to JSON
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+func RegisterK8sCurrentContextMCPResource(s *server.MCPServer) {
+	s.AddResource(newK8sCurrentContextMCPResource(), k8sCurrentContextHandler)
+}
+
+// Resource schema
+func newK8sCurrentContextMCPResource() mcp.Resource {
+	return mcp.NewResource("kubeconfig://current-context", "kubeconfig_current_context",
+		mcp.WithResourceDescription("Current user's default kubeconfig context, with its cluster name and default namespace. "+
+			"Use this resource instead of parsing kubeconfig://contexts when you only need the current context."),
+		mcp.WithMIMEType("application/json"),
+	)
+}
+
+// Resource handler
+func k8sCurrentContextHandler(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	// Read the current context from the kubeconfig
+	currentContext, err := k8s.GetCurrentContext()
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to JSON
+	jsonData, err := json.Marshal(currentContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal current context: %w", err)
+	}
+
+	// Return as MCP resource contents
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      "kubeconfig://current-context",
+			MIMEType: "application/json",
+			Text:     string(jsonData),
+		},
+	}, nil
+}
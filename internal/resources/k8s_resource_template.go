@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+	"github.com/krmcbride/mcp-k8s/internal/tools"
+)
+
+const k8sResourceURITemplate = "k8s://{context}/{namespace}/{group}/{version}/{kind}/{name}{?format}"
+
+// Placeholder path segments for the core API group and cluster-scoped resources, since some
+// clients can't produce empty URI segments
+const (
+	coreGroupSegment     = "core"
+	clusterScopedSegment = "_"
+)
+
+// k8sResourceRef identifies a single resource addressed by the k8s:// URI template
+type k8sResourceRef struct {
+	Context   string
+	Namespace string
+	GVK       schema.GroupVersionKind
+	Name      string
+	Format    string
+}
+
+func RegisterK8sResourceMCPResourceTemplate(s *server.MCPServer) {
+	s.AddResourceTemplate(newK8sResourceMCPResourceTemplate(), k8sResourceTemplateHandler)
+}
+
+// Resource template schema
+func newK8sResourceMCPResourceTemplate() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(k8sResourceURITemplate, "k8s_resource",
+		mcp.WithTemplateDescription("A single Kubernetes resource as JSON (or YAML with ?format=yaml), addressable by URI so clients can reference and cache specific cluster objects. "+
+			"Use 'core' as the group for core resources (Pods, Services, ...) and '_' as the namespace for cluster-scoped resources, "+
+			"e.g. k8s://prod/default/apps/v1/Deployment/web or k8s://prod/_/core/v1/Node/node-1. metadata.managedFields and the kubectl last-applied-configuration annotation are omitted."),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+}
+
+// Resource template handler
+func k8sResourceTemplateHandler(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	ref, err := parseK8sResourceRef(request.Params.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the tools' namespace policy before touching the cluster
+	if ref.Namespace != "" {
+		if err := tools.CheckNamespaceAccess(ref.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	mapping, err := k8s.GVKToRESTMapping(ref.Context, ref.GVK)
+	if err != nil {
+		return nil, err
+	}
+	gvr := mapping.Resource
+
+	// The cluster-scoped placeholder on a namespaced kind isn't confined to any one namespace
+	if ref.Namespace == "" && mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if err := tools.CheckNamespaceAccess(metav1.NamespaceAll); err != nil {
+			return nil, err
+		}
+	}
+
+	dynamicClient, err := k8s.GetDynamicClientForContext(ref.Context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	var resource *unstructured.Unstructured
+	if ref.Namespace == "" {
+		resource, err = dynamicClient.Resource(gvr).Get(ctx, ref.Name, metav1.GetOptions{})
+	} else {
+		resource, err = dynamicClient.Resource(gvr).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", ref.GVK.Kind, ref.Name, err)
+	}
+
+	// managedFields and the last-applied annotation are large and rarely useful to a model
+	tools.StripNoisyMetadata(resource)
+
+	data, mimeType, err := marshalK8sResource(resource.Object, ref.Format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	// Return as MCP resource contents
+	return k8sResourceContents(request.Params.URI, mimeType, string(data)), nil
+}
+
+// marshalK8sResource encodes a resource as JSON, or YAML for the yaml format, masking sensitive
+// values like tool output does when redaction is enabled
+func marshalK8sResource(object map[string]any, format string) ([]byte, string, error) {
+	data, err := tools.MarshalRedactedJSON(object)
+	if err != nil {
+		return nil, "", err
+	}
+	if format != "yaml" {
+		return data, "application/json", nil
+	}
+
+	data, err = yaml.JSONToYAML(data)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, "application/yaml", nil
+}
+
+// k8sResourceContents wraps the encoded resource, applying the tools' response size guard. Oversized
+// resources are truncated and preceded by a plain-text warning.
+func k8sResourceContents(uri, mimeType, text string) []mcp.ResourceContents {
+	text, warning := tools.TruncateResponse(text, "The content below is incomplete and not valid JSON/YAML. "+
+		"Use get_k8s_resource with statusOnly or go_template to fetch part of the object.")
+
+	contents := []mcp.ResourceContents{}
+	if warning != "" {
+		contents = append(contents, mcp.TextResourceContents{URI: uri, MIMEType: "text/plain", Text: warning})
+	}
+	return append(contents, mcp.TextResourceContents{URI: uri, MIMEType: mimeType, Text: text})
+}
+
+// parseK8sResourceRef converts the matched URI template variables into a resource reference,
+// translating the core group and cluster-scope placeholders
+func parseK8sResourceRef(args map[string]any) (*k8sResourceRef, error) {
+	ref := &k8sResourceRef{
+		Context:   templateArg(args, "context"),
+		Namespace: templateArg(args, "namespace"),
+		GVK: schema.GroupVersionKind{
+			Group:   templateArg(args, "group"),
+			Version: templateArg(args, "version"),
+			Kind:    templateArg(args, "kind"),
+		},
+		Name:   templateArg(args, "name"),
+		Format: templateArg(args, "format"),
+	}
+
+	if ref.GVK.Group == coreGroupSegment {
+		ref.GVK.Group = ""
+	}
+	if ref.Namespace == clusterScopedSegment {
+		ref.Namespace = ""
+	}
+
+	switch {
+	case ref.Context == "":
+		return nil, fmt.Errorf("resource URI is missing the context")
+	case ref.GVK.Version == "" || ref.GVK.Kind == "" || ref.Name == "":
+		return nil, fmt.Errorf("resource URI must include version, kind, and name")
+	}
+
+	switch ref.Format {
+	case "", "json", "yaml":
+	default:
+		return nil, fmt.Errorf("format must be 'json' or 'yaml', got '%s'", ref.Format)
+	}
+
+	return ref, nil
+}
+
+// templateArg returns a matched URI template variable, which mcp-go passes as a string slice
+func templateArg(args map[string]any, name string) string {
+	switch v := args[name].(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
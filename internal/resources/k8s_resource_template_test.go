@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/krmcbride/mcp-k8s/internal/tools"
+)
+
+func TestParseK8sResourceRef(t *testing.T) {
+	template := newK8sResourceMCPResourceTemplate()
+
+	tests := []struct {
+		uri     string
+		want    k8sResourceRef
+		wantErr bool
+	}{
+		{
+			uri: "k8s://prod/default/apps/v1/Deployment/web",
+			want: k8sResourceRef{
+				Context: "prod", Namespace: "default", Name: "web",
+				GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			},
+		},
+		{
+			uri: "k8s://prod/_/core/v1/Node/node-1?format=yaml",
+			want: k8sResourceRef{
+				Context: "prod", Name: "node-1", Format: "yaml",
+				GVK: schema.GroupVersionKind{Version: "v1", Kind: "Node"},
+			},
+		},
+		{uri: "k8s://prod/default/core/v1/Pod/web-1?format=xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			if !template.URITemplate.Regexp().MatchString(tt.uri) {
+				t.Fatalf("URI %q does not match template", tt.uri)
+			}
+			args := map[string]any{}
+			for name, value := range template.URITemplate.Match(tt.uri) {
+				args[name] = value.V
+			}
+
+			ref, err := parseK8sResourceRef(args)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *ref != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, *ref)
+			}
+		})
+	}
+}
+
+func TestK8sResourceTemplateNamespacePolicy(t *testing.T) {
+	tools.SetNamespacePolicy([]string{"team-a"}, nil)
+	t.Cleanup(func() { tools.SetNamespacePolicy(nil, nil) })
+
+	request := mcp.ReadResourceRequest{}
+	request.Params.URI = "k8s://prod/kube-system/core/v1/Secret/foo"
+	request.Params.Arguments = map[string]any{
+		"context":   []string{"prod"},
+		"namespace": []string{"kube-system"},
+		"group":     []string{"core"},
+		"version":   []string{"v1"},
+		"kind":      []string{"Secret"},
+		"name":      []string{"foo"},
+	}
+
+	// The policy is checked before any cluster access, so no kubeconfig is needed
+	_, err := k8sResourceTemplateHandler(context.Background(), request)
+	if err == nil || !strings.Contains(err.Error(), "kube-system") {
+		t.Fatalf("expected namespace policy error, got %v", err)
+	}
+}
+
+func TestMarshalK8sResourceRedacts(t *testing.T) {
+	if err := tools.SetRedactPattern(tools.DefaultRedactPattern); err != nil {
+		t.Fatalf("SetRedactPattern() error = %v", err)
+	}
+	t.Cleanup(func() { _ = tools.SetRedactPattern("") })
+
+	configMap := map[string]any{
+		"kind":     "ConfigMap",
+		"metadata": map[string]any{"name": "app"},
+		"data":     map[string]any{"DB_PASSWORD": "hunter2"},
+	}
+
+	for _, format := range []string{"", "yaml"} {
+		data, mimeType, err := marshalK8sResource(configMap, format)
+		if err != nil {
+			t.Fatalf("marshalK8sResource(%q) error = %v", format, err)
+		}
+		if strings.Contains(string(data), "hunter2") || !strings.Contains(string(data), "REDACTED") {
+			t.Errorf("%s output not redacted: %s", mimeType, data)
+		}
+	}
+}
+
+func TestK8sResourceContentsSizeGuard(t *testing.T) {
+	tools.SetMaxResponseBytes(10)
+	t.Cleanup(func() { tools.SetMaxResponseBytes(tools.DefaultMaxResponseBytes) })
+
+	uri := "k8s://prod/default/core/v1/ConfigMap/app"
+	if contents := k8sResourceContents(uri, "application/json", "{}"); len(contents) != 1 {
+		t.Fatalf("expected 1 content item under threshold, got %d", len(contents))
+	}
+
+	contents := k8sResourceContents(uri, "application/json", strings.Repeat("x", 100))
+	if len(contents) != 2 {
+		t.Fatalf("expected warning and data content items, got %d", len(contents))
+	}
+	warning := contents[0].(mcp.TextResourceContents)
+	if warning.MIMEType != "text/plain" || !strings.Contains(warning.Text, "statusOnly") {
+		t.Errorf("unexpected warning: %+v", warning)
+	}
+	if data := contents[1].(mcp.TextResourceContents); len(data.Text) != 10 || data.MIMEType != "application/json" {
+		t.Errorf("expected 10 bytes of application/json, got %d bytes of %s", len(data.Text), data.MIMEType)
+	}
+}
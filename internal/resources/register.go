@@ -4,9 +4,19 @@ package resources
 
 import (
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/krmcbride/mcp-k8s/internal/tools"
 )
 
+// RegisterMCPResources registers resources and resource templates. Call tools.SetDisabledTools
+// first: the k8s:// template serves the same objects as get_k8s_resource and is skipped with it.
 func RegisterMCPResources(s *server.MCPServer) {
 	// Register resources
 	RegisterK8sContextsMCPResource(s)
+	RegisterK8sCurrentContextMCPResource(s)
+
+	// Register resource templates
+	if !tools.IsToolDisabled("get_k8s_resource") {
+		RegisterK8sResourceMCPResourceTemplate(s)
+	}
 }
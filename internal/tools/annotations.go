@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+const (
+	annotationsProperty      = "annotations"
+	annotationFilterProperty = "annotationFilter"
+)
+
+// annotationRequirement is one term of an annotation filter
+type annotationRequirement struct {
+	key      string
+	operator selection.Operator // Exists, DoesNotExist, Equals, or NotEquals
+	value    string
+}
+
+// parseAnnotationFilter parses comma-separated annotation filter terms: 'key' (present), '!key'
+// (absent), 'key=value' or 'key==value', and 'key!=value'. Unlike label selectors, values aren't
+// restricted to label value syntax, since annotation values are often URLs or IDs.
+func parseAnnotationFilter(expr string) ([]annotationRequirement, error) {
+	var requirements []annotationRequirement
+	for _, term := range strings.Split(expr, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var requirement annotationRequirement
+		switch {
+		case strings.HasPrefix(term, "!") && !strings.Contains(term, "="):
+			requirement = annotationRequirement{key: strings.TrimPrefix(term, "!"), operator: selection.DoesNotExist}
+		case strings.Contains(term, "!="):
+			key, value, _ := strings.Cut(term, "!=")
+			requirement = annotationRequirement{key: key, operator: selection.NotEquals, value: value}
+		case strings.Contains(term, "=="):
+			key, value, _ := strings.Cut(term, "==")
+			requirement = annotationRequirement{key: key, operator: selection.Equals, value: value}
+		case strings.Contains(term, "="):
+			key, value, _ := strings.Cut(term, "=")
+			requirement = annotationRequirement{key: key, operator: selection.Equals, value: value}
+		default:
+			requirement = annotationRequirement{key: term, operator: selection.Exists}
+		}
+
+		requirement.key = strings.TrimSpace(requirement.key)
+		requirement.value = strings.TrimSpace(requirement.value)
+		if requirement.key == "" {
+			return nil, fmt.Errorf("invalid %s '%s': every term needs an annotation key. Expected comma-separated 'key', '!key', 'key=value', or 'key!=value' terms", annotationFilterProperty, expr)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// matches reports whether the annotations satisfy the requirement
+func (r annotationRequirement) matches(annotations map[string]string) bool {
+	value, found := annotations[r.key]
+	switch r.operator {
+	case selection.Exists:
+		return found
+	case selection.DoesNotExist:
+		return !found
+	case selection.Equals:
+		return found && value == r.value
+	case selection.NotEquals:
+		return !found || value != r.value
+	}
+	return false
+}
+
+// filterItemsByAnnotations returns the items whose annotations satisfy every requirement
+func filterItemsByAnnotations(items []unstructured.Unstructured, requirements []annotationRequirement) []unstructured.Unstructured {
+	var filtered []unstructured.Unstructured
+	for _, item := range items {
+		annotations := item.GetAnnotations()
+		matched := true
+		for _, requirement := range requirements {
+			if !requirement.matches(annotations) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// addRequestedAnnotations adds an "annotations" field holding the requested annotation keys to each
+// mapped item that has any of them. content must be mapped from items in the same order. The
+// last-applied-configuration annotation, a copy of the whole object, is skipped unless
+// includeLastApplied; when included, redaction masks the object it encodes like any other output.
+func addRequestedAnnotations(content []any, items []unstructured.Unstructured, keys []string, includeLastApplied bool) []any {
+	for i, item := range items {
+		annotations := item.GetAnnotations()
+		requested := map[string]string{}
+		for _, key := range keys {
+			if key == lastAppliedConfigAnnotation && !includeLastApplied {
+				continue
+			}
+			if value, found := annotations[key]; found {
+				requested[key] = value
+			}
+		}
+		if len(requested) > 0 {
+			content[i] = withJSONField(content[i], annotationsProperty, requested)
+		}
+	}
+	return content
+}
+
+// withJSONField appends a field to the JSON encoding of mapped content. Mappers return a struct per
+// kind, so the field is spliced into the encoded object to keep the mapper's field order. Content
+// that doesn't encode to a JSON object is returned unchanged.
+func withJSONField(content any, key string, value any) any {
+	encoded, err := json.Marshal(content)
+	if err != nil || len(encoded) < 2 || encoded[0] != '{' || encoded[len(encoded)-1] != '}' {
+		return content
+	}
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		return content
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return content
+	}
+
+	var buf bytes.Buffer
+	buf.Write(encoded[:len(encoded)-1])
+	if len(encoded) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.Write(encodedKey)
+	buf.WriteByte(':')
+	buf.Write(encodedValue)
+	buf.WriteByte('}')
+	return json.RawMessage(buf.Bytes())
+}
@@ -0,0 +1,142 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+func newAnnotatedItem(name string, annotations map[string]string) unstructured.Unstructured {
+	item := unstructured.Unstructured{Object: map[string]any{}}
+	item.SetName(name)
+	item.SetAnnotations(annotations)
+	return item
+}
+
+func TestParseAnnotationFilter(t *testing.T) {
+	requirements, err := parseAnnotationFilter("argocd.argoproj.io/tracking-id, !example.com/skip,example.com/owner=team-a,example.com/tier==web,example.com/env!=dev,example.com/url=https://x.io/a?b=c")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []annotationRequirement{
+		{key: "argocd.argoproj.io/tracking-id", operator: selection.Exists},
+		{key: "example.com/skip", operator: selection.DoesNotExist},
+		{key: "example.com/owner", operator: selection.Equals, value: "team-a"},
+		{key: "example.com/tier", operator: selection.Equals, value: "web"},
+		{key: "example.com/env", operator: selection.NotEquals, value: "dev"},
+		{key: "example.com/url", operator: selection.Equals, value: "https://x.io/a?b=c"},
+	}
+	if len(requirements) != len(want) {
+		t.Fatalf("expected %d requirements, got %+v", len(want), requirements)
+	}
+	for i := range want {
+		if requirements[i] != want[i] {
+			t.Errorf("requirement %d = %+v, want %+v", i, requirements[i], want[i])
+		}
+	}
+
+	if _, err := parseAnnotationFilter("=value"); err == nil {
+		t.Error("expected error for a term without a key")
+	}
+}
+
+func TestFilterItemsByAnnotations(t *testing.T) {
+	items := []unstructured.Unstructured{
+		newAnnotatedItem("tracked", map[string]string{"argocd.argoproj.io/tracking-id": "app:apps/Deployment:ns/tracked", "example.com/owner": "team-a"}),
+		newAnnotatedItem("other-team", map[string]string{"argocd.argoproj.io/tracking-id": "app:apps/Deployment:ns/other", "example.com/owner": "team-b"}),
+		newAnnotatedItem("untracked", nil),
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: "argocd.argoproj.io/tracking-id", want: []string{"tracked", "other-team"}},
+		{filter: "!argocd.argoproj.io/tracking-id", want: []string{"untracked"}},
+		{filter: "example.com/owner=team-a", want: []string{"tracked"}},
+		{filter: "example.com/owner!=team-a", want: []string{"other-team", "untracked"}},
+		{filter: "argocd.argoproj.io/tracking-id,example.com/owner!=team-a", want: []string{"other-team"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			requirements, err := parseAnnotationFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, item := range filterItemsByAnnotations(items, requirements) {
+				got = append(got, item.GetName())
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAddRequestedAnnotations(t *testing.T) {
+	type mapped struct {
+		Name  string `json:"name"`
+		Ready bool   `json:"ready"`
+	}
+	items := []unstructured.Unstructured{
+		newAnnotatedItem("web", map[string]string{"deployment.kubernetes.io/revision": "7", "unrelated": "x"}),
+		newAnnotatedItem("api", nil),
+	}
+	content := []any{mapped{Name: "web", Ready: true}, mapped{Name: "api"}}
+
+	content = addRequestedAnnotations(content, items, []string{"deployment.kubernetes.io/revision"}, false)
+
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `[{"name":"web","ready":true,"annotations":{"deployment.kubernetes.io/revision":"7"}},{"name":"api","ready":false}]`
+	if string(encoded) != want {
+		t.Errorf("got %s, want %s", encoded, want)
+	}
+}
+
+func TestAddRequestedAnnotationsLastApplied(t *testing.T) {
+	if err := SetRedactPattern(DefaultRedactPattern); err != nil {
+		t.Fatalf("SetRedactPattern() error = %v", err)
+	}
+	t.Cleanup(func() { _ = SetRedactPattern("") })
+
+	lastApplied := `{"kind":"ConfigMap","data":{"DB_PASSWORD":"hunter2"}}`
+	items := []unstructured.Unstructured{
+		newAnnotatedItem("app", map[string]string{lastAppliedConfigAnnotation: lastApplied}),
+	}
+	keys := []string{lastAppliedConfigAnnotation}
+
+	t.Run("skipped by default", func(t *testing.T) {
+		content := addRequestedAnnotations([]any{map[string]any{"name": "app"}}, items, keys, false)
+		encoded, err := MarshalRedactedJSON(content)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := `[{"name":"app"}]`; string(encoded) != want {
+			t.Errorf("got %s, want %s", encoded, want)
+		}
+	})
+
+	t.Run("included and redacted", func(t *testing.T) {
+		content := addRequestedAnnotations([]any{map[string]any{"name": "app"}}, items, keys, true)
+		encoded, err := MarshalRedactedJSON(content)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Contains(string(encoded), "hunter2") || !strings.Contains(string(encoded), "REDACTED") {
+			t.Errorf("expected the annotation's contents to be redacted, got %s", encoded)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+type checkK8sServiceEndpointsParams struct {
+	Context   string
+	Namespace string
+	Name      string
+}
+
+// ServiceEndpointsResult summarizes whether a Service has endpoints to route traffic to
+type ServiceEndpointsResult struct {
+	Service        string            `json:"service"`
+	Namespace      string            `json:"namespace"`
+	Type           string            `json:"type,omitempty"`
+	Selector       map[string]string `json:"selector,omitempty"`
+	EndpointSlices int               `json:"endpointSlices"`
+	Ready          int               `json:"ready"`
+	NotReady       int               `json:"notReady"`
+	ReadyPods      []string          `json:"readyPods,omitempty"`
+	NotReadyPods   []string          `json:"notReadyPods,omitempty"`
+	Hint           string            `json:"hint,omitempty"`
+}
+
+func RegisterCheckK8sServiceEndpointsMCPTool(s *server.MCPServer) {
+	addTool(s, newCheckK8sServiceEndpointsMCPTool(), checkK8sServiceEndpointsHandler)
+}
+
+// Tool schema
+func newCheckK8sServiceEndpointsMCPTool() mcp.Tool {
+	return mcp.NewTool("check_k8s_service_endpoints", readOnlyToolOptions(
+		mcp.WithDescription("Check whether a Service has ready endpoints by inspecting its EndpointSlices. Reports ready vs not-ready addresses and the backing pods, answering 'why does my service return nothing?'"),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace of the service."),
+			mcp.Required(),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("The name of the service to check."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func checkK8sServiceEndpointsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractCheckK8sServiceEndpointsParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get Kubernetes clientset
+	clientset, err := k8s.GetClientsetForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create Kubernetes clientset: %v", err)), nil
+	}
+
+	// Get the service
+	service, err := clientset.CoreV1().Services(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get service: %v", err)), nil
+	}
+
+	// Find the service's EndpointSlices via the well-known label
+	selector := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: params.Name})
+	slices, err := clientset.DiscoveryV1().EndpointSlices(params.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list endpoint slices: %v", err)), nil
+	}
+
+	result := summarizeServiceEndpoints(slices.Items)
+	result.Service = service.Name
+	result.Namespace = service.Namespace
+	result.Type = string(service.Spec.Type)
+	result.Selector = service.Spec.Selector
+	result.Hint = serviceEndpointsHint(result)
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+func extractCheckK8sServiceEndpointsParams(request mcp.CallToolRequest) (*checkK8sServiceEndpointsParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := request.RequireString(nameProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkK8sServiceEndpointsParams{
+		Context:   context,
+		Namespace: namespace,
+		Name:      name,
+	}, nil
+}
+
+// summarizeServiceEndpoints counts ready and not-ready addresses and collects backing pods
+func summarizeServiceEndpoints(slices []discoveryv1.EndpointSlice) *ServiceEndpointsResult {
+	result := &ServiceEndpointsResult{EndpointSlices: len(slices)}
+	readyPods := map[string]bool{}
+	notReadyPods := map[string]bool{}
+
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition means ready per the API contract
+			ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			if ready {
+				result.Ready += len(endpoint.Addresses)
+			} else {
+				result.NotReady += len(endpoint.Addresses)
+			}
+
+			if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+				if ready {
+					readyPods[endpoint.TargetRef.Name] = true
+				} else {
+					notReadyPods[endpoint.TargetRef.Name] = true
+				}
+			}
+		}
+	}
+
+	result.ReadyPods = sortedKeys(readyPods)
+	result.NotReadyPods = sortedKeys(notReadyPods)
+	return result
+}
+
+// serviceEndpointsHint explains the most likely cause when a service has no ready endpoints
+func serviceEndpointsHint(result *ServiceEndpointsResult) string {
+	switch {
+	case result.Ready > 0:
+		return ""
+	case result.Type == "ExternalName":
+		return "ExternalName services resolve via DNS and do not have endpoints."
+	case len(result.Selector) == 0:
+		return "Service has no selector, so endpoints must be managed manually. No ready endpoints were found."
+	case result.NotReady > 0:
+		return "Pods match the service selector but none are ready. Check pod readiness probes and status."
+	default:
+		return "No pods match the service selector. Verify the selector matches the labels of running pods."
+	}
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+func TestSummarizeServiceEndpoints(t *testing.T) {
+	notReady := false
+	slices := []discoveryv1.EndpointSlice{
+		{
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.1"}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-b"}},
+				{Addresses: []string{"10.0.0.2"}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-a"}},
+			},
+		},
+		{
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-c"}},
+			},
+		},
+	}
+
+	result := summarizeServiceEndpoints(slices)
+	if result.EndpointSlices != 2 || result.Ready != 2 || result.NotReady != 1 {
+		t.Errorf("EndpointSlices/Ready/NotReady = %d/%d/%d, want 2/2/1", result.EndpointSlices, result.Ready, result.NotReady)
+	}
+	if !reflect.DeepEqual(result.ReadyPods, []string{"web-a", "web-b"}) {
+		t.Errorf("ReadyPods = %v", result.ReadyPods)
+	}
+	if !reflect.DeepEqual(result.NotReadyPods, []string{"web-c"}) {
+		t.Errorf("NotReadyPods = %v", result.NotReadyPods)
+	}
+}
+
+func TestServiceEndpointsHint(t *testing.T) {
+	selector := map[string]string{"app": "web"}
+	tests := []struct {
+		name     string
+		result   ServiceEndpointsResult
+		wantHint bool
+	}{
+		{name: "ready endpoints", result: ServiceEndpointsResult{Selector: selector, Ready: 1}, wantHint: false},
+		{name: "no matching pods", result: ServiceEndpointsResult{Selector: selector}, wantHint: true},
+		{name: "pods not ready", result: ServiceEndpointsResult{Selector: selector, NotReady: 2}, wantHint: true},
+		{name: "no selector", result: ServiceEndpointsResult{}, wantHint: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hint := serviceEndpointsHint(&tt.result)
+			if (hint != "") != tt.wantHint {
+				t.Errorf("serviceEndpointsHint() = %q, wantHint %t", hint, tt.wantHint)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+const (
+	defaultMetricsCompareInterval = time.Minute
+	defaultMetricsCompareLimit    = 50
+
+	// Memory growth below either threshold is treated as noise rather than a potential leak
+	minLeakGrowthBytes   = 1024 * 1024
+	minLeakGrowthPercent = 2.0
+)
+
+type compareK8sPodMetricsParams struct {
+	Context       string
+	Namespace     string
+	AllNamespaces bool
+	Name          string
+	LabelSelector string
+	Container     string
+	Interval      time.Duration
+	SortBy        string
+	Limit         int64
+}
+
+// MetricsDelta is the change in CPU and memory usage between two samples
+type MetricsDelta struct {
+	CPUBeforeMillicores int64   `json:"cpuBeforeMillicores"`
+	CPUAfterMillicores  int64   `json:"cpuAfterMillicores"`
+	CPUChangeMillicores int64   `json:"cpuChangeMillicores"`
+	MemoryBeforeMiB     int64   `json:"memoryBeforeMiB"`
+	MemoryAfterMiB      int64   `json:"memoryAfterMiB"`
+	MemoryChangeMiB     int64   `json:"memoryChangeMiB"`
+	MemoryChangeBytes   int64   `json:"memoryChangeBytes"`
+	MemoryChangePercent float64 `json:"memoryChangePercent"` // Relative to the first sample
+	PotentialLeak       bool    `json:"potentialLeak,omitempty"`
+}
+
+// PodMetricsDelta is the change in a pod's usage, and each of its containers', between two samples
+type PodMetricsDelta struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	MetricsDelta
+	Containers []ContainerMetricsDelta `json:"containers"`
+}
+
+// ContainerMetricsDelta is the change in a container's usage between two samples
+type ContainerMetricsDelta struct {
+	Name string `json:"name"`
+	MetricsDelta
+}
+
+func RegisterCompareK8sPodMetricsMCPTool(s *server.MCPServer) {
+	addTool(s, newCompareK8sPodMetricsMCPTool(), compareK8sPodMetricsHandler)
+}
+
+// Tool schema
+func newCompareK8sPodMetricsMCPTool() mcp.Tool {
+	return mcp.NewTool("compare_k8s_pod_metrics", readOnlyToolOptions(
+		mcp.WithDescription("Take two pod metrics samples spaced by interval and return the change in CPU and memory usage per pod and container, largest growth first. "+
+			"Pods whose memory grew with no container shrinking are flagged potentialLeak, a trend signal for memory leaks that a single get_k8s_metrics snapshot can't give. "+
+			"The call blocks for the interval."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to use. Defaults to the context's configured namespace, or all namespaces if the context doesn't set one."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("Compare pod metrics across all namespaces, ignoring the context's default namespace. Cannot be used with namespace."),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("Optional pod name to compare a single pod."),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Optional label selector to filter pods (e.g., 'app=web'). Cannot be used with name."),
+		),
+		mcp.WithString(containerProperty,
+			mcp.Description("Optional container name to limit per-container deltas. Pod totals still include all containers."),
+		),
+		mcp.WithString(intervalProperty,
+			mcp.Description(fmt.Sprintf("Time between the two samples (e.g., '30s', '2m'). Defaults to %s, maximum %s. "+
+				"metrics-server refreshes usage about every 15s, so shorter intervals compare the same sample.", defaultMetricsCompareInterval, maxMetricsSamplingDuration)),
+		),
+		mcp.WithString(sortByProperty,
+			mcp.Description("Sort order: 'memory' (default) or 'cpu', largest increase first."),
+			mcp.Enum(sortByMemory, sortByCPU),
+		),
+		mcp.WithNumber(limitProperty,
+			mcp.Description(fmt.Sprintf("Maximum number of pods to return. Defaults to %d; 0 returns all.", defaultMetricsCompareLimit)),
+		),
+	)...)
+}
+
+// Tool handler
+func compareK8sPodMetricsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractCompareK8sPodMetricsParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Preflight check so a missing metrics-server isn't reported as a generic failure
+	if available, err := k8s.IsMetricsAPIAvailable(params.Context); err == nil && !available {
+		return mcp.NewToolResultError("metrics-server not available on this cluster (the metrics.k8s.io API is not registered); install it to use compare_k8s_pod_metrics"), nil
+	}
+
+	// Fall back to the context's default namespace, like get_k8s_metrics
+	if params.Namespace == "" && !params.AllNamespaces {
+		params.Namespace, err = k8s.GetContextNamespace(params.Context)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read context namespace: %v", err)), nil
+		}
+	}
+	if err := CheckNamespaceAccess(params.Namespace); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get metrics client
+	metricsClient, err := k8s.GetMetricsClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create metrics client: %v", err)), nil
+	}
+
+	metricsParams := &getK8sMetricsParams{
+		Context:       params.Context,
+		Kind:          "pod",
+		Namespace:     params.Namespace,
+		Name:          params.Name,
+		LabelSelector: params.LabelSelector,
+		Container:     params.Container,
+	}
+
+	before, _, err := getPodMetrics(ctx, metricsClient, metricsParams)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod metrics: %v", err)), nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return mcp.NewToolResultError(fmt.Sprintf("Comparison cancelled before the second sample: %v", ctx.Err())), nil
+	case <-time.After(params.Interval):
+	}
+
+	after, _, err := getPodMetrics(ctx, metricsClient, metricsParams)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod metrics: %v", err)), nil
+	}
+
+	deltas, unchanged, onlyBefore, onlyAfter := comparePodMetrics(before, after)
+	sortPodMetricsDeltas(deltas, params.SortBy)
+
+	potentialLeaks := 0
+	for _, delta := range deltas {
+		if delta.PotentialLeak {
+			potentialLeaks++
+		}
+	}
+
+	response := map[string]any{
+		"interval":       params.Interval.String(),
+		"comparedPods":   len(deltas),
+		"potentialLeaks": potentialLeaks,
+	}
+	if unchanged > 0 {
+		response["warning"] = fmt.Sprintf("%d pods had the same metrics-server sample both times and show no change; use a longer interval", unchanged)
+	}
+	if len(onlyBefore) > 0 {
+		response["goneBeforeSecondSample"] = onlyBefore
+	}
+	if len(onlyAfter) > 0 {
+		response["newInSecondSample"] = onlyAfter
+	}
+	if params.Limit > 0 && int64(len(deltas)) > params.Limit {
+		response["truncated"] = fmt.Sprintf("showing the top %d of %d pods; raise limit to see more", params.Limit, len(deltas))
+		deltas = deltas[:params.Limit]
+	}
+	response["items"] = deltas
+
+	// Return as JSON
+	return toJSONToolResult(response)
+}
+
+// comparePodMetrics pairs pods present in both samples and computes their deltas. It also returns
+// how many pods had the same sample timestamp both times, and the "namespace/name" of pods seen
+// in only one sample, e.g. because they were deleted or started in between.
+func comparePodMetrics(before, after []PodMetrics) (deltas []PodMetricsDelta, unchanged int, onlyBefore, onlyAfter []string) {
+	afterByKey := make(map[string]PodMetrics, len(after))
+	for _, pod := range after {
+		afterByKey[pod.Namespace+"/"+pod.Name] = pod
+	}
+
+	deltas = []PodMetricsDelta{}
+	seen := make(map[string]bool, len(before))
+	for _, first := range before {
+		key := first.Namespace + "/" + first.Name
+		seen[key] = true
+		second, ok := afterByKey[key]
+		if !ok {
+			onlyBefore = append(onlyBefore, key)
+			continue
+		}
+		if first.Timestamp != "" && first.Timestamp == second.Timestamp {
+			unchanged++
+		}
+		deltas = append(deltas, podMetricsDelta(first, second))
+	}
+	for _, pod := range after {
+		if key := pod.Namespace + "/" + pod.Name; !seen[key] {
+			onlyAfter = append(onlyAfter, key)
+		}
+	}
+
+	return deltas, unchanged, onlyBefore, onlyAfter
+}
+
+// podMetricsDelta computes the change in a pod's usage. A container is flagged as a potential leak
+// when its memory grew past the noise thresholds; the pod is flagged when its total memory did and
+// none of its containers' memory fell, so a restart or a shrinking sidecar doesn't read as a leak.
+// Containers present in only one sample, such as one that restarted in between, are skipped.
+func podMetricsDelta(before, after PodMetrics) PodMetricsDelta {
+	delta := PodMetricsDelta{
+		Name:         after.Name,
+		Namespace:    after.Namespace,
+		MetricsDelta: metricsDelta(before.CPUUsageMillicores, after.CPUUsageMillicores, before.MemoryUsageBytes, after.MemoryUsageBytes),
+		Containers:   []ContainerMetricsDelta{},
+	}
+
+	beforeContainers := make(map[string]ContainerMetrics, len(before.Containers))
+	for _, container := range before.Containers {
+		beforeContainers[container.Name] = container
+	}
+
+	monotonic := true
+	for _, second := range after.Containers {
+		first, ok := beforeContainers[second.Name]
+		if !ok {
+			continue
+		}
+		containerDelta := metricsDelta(first.CPUUsageMillicores, second.CPUUsageMillicores, first.MemoryUsageBytes, second.MemoryUsageBytes)
+		if containerDelta.MemoryChangeBytes < 0 {
+			monotonic = false
+		}
+		delta.Containers = append(delta.Containers, ContainerMetricsDelta{Name: second.Name, MetricsDelta: containerDelta})
+	}
+	delta.PotentialLeak = delta.PotentialLeak && monotonic
+
+	return delta
+}
+
+// metricsDelta computes the change between two usage samples, flagging memory growth past the
+// noise thresholds as a potential leak
+func metricsDelta(cpuBefore, cpuAfter, memoryBefore, memoryAfter int64) MetricsDelta {
+	delta := MetricsDelta{
+		CPUBeforeMillicores: cpuBefore,
+		CPUAfterMillicores:  cpuAfter,
+		CPUChangeMillicores: cpuAfter - cpuBefore,
+		MemoryBeforeMiB:     bytesToMiB(memoryBefore),
+		MemoryAfterMiB:      bytesToMiB(memoryAfter),
+		MemoryChangeMiB:     bytesToMiB(memoryAfter) - bytesToMiB(memoryBefore),
+		MemoryChangeBytes:   memoryAfter - memoryBefore,
+	}
+	if memoryBefore > 0 {
+		// Rounded to a tenth of a percent for readability
+		percent := float64(delta.MemoryChangeBytes) / float64(memoryBefore) * 100
+		delta.MemoryChangePercent = math.Round(percent*10) / 10
+	}
+	delta.PotentialLeak = delta.MemoryChangeBytes >= minLeakGrowthBytes &&
+		(memoryBefore == 0 || float64(delta.MemoryChangeBytes)/float64(memoryBefore)*100 >= minLeakGrowthPercent)
+	return delta
+}
+
+// sortPodMetricsDeltas orders pods by the largest increase in the given resource, ties broken by
+// namespace and name
+func sortPodMetricsDeltas(deltas []PodMetricsDelta, sortBy string) {
+	sort.SliceStable(deltas, func(i, j int) bool {
+		a, b := deltas[i], deltas[j]
+		changeA, changeB := a.MemoryChangeBytes, b.MemoryChangeBytes
+		if sortBy == sortByCPU {
+			changeA, changeB = a.CPUChangeMillicores, b.CPUChangeMillicores
+		}
+		if changeA != changeB {
+			return changeA > changeB
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
+func extractCompareK8sPodMetricsParams(request mcp.CallToolRequest) (*compareK8sPodMetricsParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	name := request.GetString(nameProperty, "")
+	labelSelector := request.GetString(labelSelectorProperty, "")
+	if name != "" && labelSelector != "" {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", nameProperty, labelSelectorProperty)
+	}
+
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	interval := defaultMetricsCompareInterval
+	if intervalStr := request.GetString(intervalProperty, ""); intervalStr != "" {
+		interval, err = time.ParseDuration(intervalStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval duration: %w", err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("interval must be positive, got %s", intervalStr)
+		}
+		if interval > maxMetricsSamplingDuration {
+			return nil, fmt.Errorf("interval %s is more than the maximum of %s", interval, maxMetricsSamplingDuration)
+		}
+	}
+
+	sortBy := strings.ToLower(request.GetString(sortByProperty, sortByMemory))
+	if sortBy != sortByCPU && sortBy != sortByMemory {
+		return nil, fmt.Errorf("%s must be '%s' or '%s', got '%s'", sortByProperty, sortByMemory, sortByCPU, sortBy)
+	}
+
+	limit, err := extractLimit(request, defaultMetricsCompareLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compareK8sPodMetricsParams{
+		Context:       context,
+		Namespace:     namespace,
+		AllNamespaces: allNamespaces,
+		Name:          name,
+		LabelSelector: labelSelector,
+		Container:     request.GetString(containerProperty, ""),
+		Interval:      interval,
+		SortBy:        sortBy,
+		Limit:         limit,
+	}, nil
+}
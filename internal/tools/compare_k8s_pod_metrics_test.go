@@ -0,0 +1,153 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestComparePodMetrics(t *testing.T) {
+	const mib = 1024 * 1024
+	pod := func(name, timestamp string, containers ...ContainerMetrics) PodMetrics {
+		p := PodMetrics{Name: name, Namespace: "default", Timestamp: timestamp, Containers: containers}
+		for _, c := range containers {
+			p.CPUUsageMillicores += c.CPUUsageMillicores
+			p.MemoryUsageBytes += c.MemoryUsageBytes
+		}
+		return p
+	}
+	container := func(name string, cpu, memoryMiB int64) ContainerMetrics {
+		return ContainerMetrics{Name: name, CPUUsageMillicores: cpu, MemoryUsageBytes: memoryMiB * mib}
+	}
+
+	before := []PodMetrics{
+		pod("leaky", "t1", container("app", 100, 200), container("proxy", 10, 50)),
+		pod("sidecar-shrank", "t1", container("app", 100, 200), container("proxy", 10, 100)),
+		pod("steady", "t1", container("app", 300, 500)),
+		pod("stale", "t1", container("app", 50, 100)),
+		pod("deleted", "t1", container("app", 50, 100)),
+	}
+	after := []PodMetrics{
+		pod("leaky", "t2", container("app", 150, 260), container("proxy", 10, 50)),
+		pod("sidecar-shrank", "t2", container("app", 100, 300), container("proxy", 10, 50)),
+		pod("steady", "t2", container("app", 500, 500)),
+		pod("stale", "t1", container("app", 50, 100)),
+		pod("started", "t2", container("app", 50, 100)),
+	}
+
+	deltas, unchanged, onlyBefore, onlyAfter := comparePodMetrics(before, after)
+	if unchanged != 1 {
+		t.Errorf("expected 1 unchanged pod, got %d", unchanged)
+	}
+	if len(onlyBefore) != 1 || onlyBefore[0] != "default/deleted" {
+		t.Errorf("expected default/deleted only in first sample, got %v", onlyBefore)
+	}
+	if len(onlyAfter) != 1 || onlyAfter[0] != "default/started" {
+		t.Errorf("expected default/started only in second sample, got %v", onlyAfter)
+	}
+	if len(deltas) != 4 {
+		t.Fatalf("expected 4 deltas, got %d", len(deltas))
+	}
+
+	leaky := deltas[0]
+	if leaky.MemoryChangeMiB != 60 || leaky.CPUChangeMillicores != 50 || leaky.MemoryChangePercent != 24 {
+		t.Errorf("unexpected leaky delta: %+v", leaky.MetricsDelta)
+	}
+	if !leaky.PotentialLeak || !leaky.Containers[0].PotentialLeak || leaky.Containers[1].PotentialLeak {
+		t.Errorf("expected leaky pod and its app container to be flagged: %+v", leaky)
+	}
+
+	// Total memory grew, but a shrinking container means it isn't monotonic growth
+	if shrank := deltas[1]; shrank.MemoryChangeMiB != 50 || shrank.PotentialLeak {
+		t.Errorf("expected sidecar-shrank to grow 50 MiB without being flagged: %+v", shrank.MetricsDelta)
+	}
+	if steady := deltas[2]; steady.PotentialLeak || steady.CPUChangeMillicores != 200 {
+		t.Errorf("unexpected steady delta: %+v", steady.MetricsDelta)
+	}
+
+	sortPodMetricsDeltas(deltas, sortByMemory)
+	if deltas[0].Name != "leaky" || deltas[1].Name != "sidecar-shrank" {
+		t.Errorf("expected memory growth order leaky, sidecar-shrank, got %s, %s", deltas[0].Name, deltas[1].Name)
+	}
+	sortPodMetricsDeltas(deltas, sortByCPU)
+	if deltas[0].Name != "steady" {
+		t.Errorf("expected steady first by cpu, got %s", deltas[0].Name)
+	}
+}
+
+func TestMetricsDeltaLeakThresholds(t *testing.T) {
+	const mib = 1024 * 1024
+	tests := []struct {
+		name          string
+		before, after int64
+		want          bool
+	}{
+		{name: "below byte threshold", before: 10 * mib, after: 10*mib + 512*1024},
+		{name: "below percent threshold", before: 1000 * mib, after: 1010 * mib},
+		{name: "growth", before: 100 * mib, after: 110 * mib, want: true},
+		{name: "from zero", before: 0, after: 5 * mib, want: true},
+		{name: "shrinking", before: 100 * mib, after: 50 * mib},
+	}
+
+	// 1 MiB of 3 MiB is 33.33...%, and 2 MiB of 3 MiB is 66.66...%, which rounds up
+	if got := metricsDelta(0, 0, 3*mib, 4*mib).MemoryChangePercent; got != 33.3 {
+		t.Errorf("expected 33.3%%, got %v", got)
+	}
+	if got := metricsDelta(0, 0, 3*mib, 5*mib).MemoryChangePercent; got != 66.7 {
+		t.Errorf("expected 66.7%%, got %v", got)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := metricsDelta(0, 0, tt.before, tt.after).PotentialLeak; got != tt.want {
+				t.Errorf("expected potentialLeak %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExtractCompareK8sPodMetricsParams(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         map[string]any
+		wantInterval time.Duration
+		wantErr      bool
+	}{
+		{name: "defaults", args: map[string]any{}, wantInterval: defaultMetricsCompareInterval},
+		{name: "custom interval", args: map[string]any{"interval": "30s"}, wantInterval: 30 * time.Second},
+		{name: "interval too long", args: map[string]any{"interval": "10m"}, wantErr: true},
+		{name: "negative interval", args: map[string]any{"interval": "-1m"}, wantErr: true},
+		{name: "name and selector", args: map[string]any{"name": "web", "labelSelector": "app=web"}, wantErr: true},
+		{name: "namespace and allNamespaces", args: map[string]any{"namespace": "prod", "allNamespaces": true}, wantErr: true},
+		{name: "bad sortBy", args: map[string]any{"sortBy": "disk"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			args := map[string]any{"context": "prod"}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			request.Params.Arguments = args
+
+			params, err := extractCompareK8sPodMetricsParams(request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", params)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if params.Interval != tt.wantInterval {
+				t.Errorf("expected interval %s, got %s", tt.wantInterval, params.Interval)
+			}
+			if params.SortBy != sortByMemory || params.Limit != defaultMetricsCompareLimit {
+				t.Errorf("expected memory sort and default limit, got %q and %d", params.SortBy, params.Limit)
+			}
+		})
+	}
+}
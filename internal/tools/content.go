@@ -1,15 +1,29 @@
 package tools
 
 import (
-	"encoding/json"
+	"fmt"
+	"unicode/utf8"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
 
 	"github.com/krmcbride/mcp-k8s/internal/tools/mapper"
 )
 
+// DefaultMaxResponseBytes keeps responses under the ~25k token MCP tool response limit,
+// assuming roughly 4 bytes per token
+const DefaultMaxResponseBytes = 100_000
+
+// maxResponseBytes is the size above which tool responses are truncated; 0 disables the guard
+var maxResponseBytes = DefaultMaxResponseBytes
+
+// SetMaxResponseBytes sets the response size threshold. A value of 0 or less disables truncation.
+func SetMaxResponseBytes(n int) {
+	maxResponseBytes = n
+}
+
 func mapToK8sResourceListContent(list *unstructured.UnstructuredList, gvk schema.GroupVersionKind) []any {
 	content := make([]any, 0, len(list.Items))
 
@@ -42,9 +56,79 @@ func mapToK8sResourceContent(resource *unstructured.Unstructured, gvk schema.Gro
 }
 
 func toJSONToolResult(content any) (*mcp.CallToolResult, error) {
-	jsonContent, err := json.Marshal(content)
+	jsonContent, err := MarshalRedactedJSON(content)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	return mcp.NewToolResultText(string(jsonContent)), nil
+	return sizeGuardedToolResult(string(jsonContent), listTruncationHint), nil
+}
+
+func toYAMLToolResult(content any) (*mcp.CallToolResult, error) {
+	// Convert via JSON like yaml.Marshal does, so redaction applies to YAML output too
+	jsonContent, err := MarshalRedactedJSON(content)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	yamlContent, err := yaml.JSONToYAML(jsonContent)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return sizeGuardedToolResult(string(yamlContent), listTruncationHint), nil
+}
+
+// Hints appended to the truncation warning, telling the caller how to get a complete result
+const (
+	listTruncationHint = "The content below is incomplete and not valid JSON/YAML. " +
+		"Narrow the query with limit, namespace, labelSelector, fieldSelector, or name to get a complete result."
+	logTruncationHint = "The log below is incomplete. " +
+		"Narrow it with tail, head, since, or sinceTime to get the lines you need."
+	schemaTruncationHint = "The content below is incomplete and not valid JSON. " +
+		"Pass path to return only part of the schema."
+)
+
+// sizeGuardedToolResult returns the text as-is when it fits within maxResponseBytes. Oversized
+// text is truncated and preceded by a warning ending in hint so clients don't silently fail on
+// the payload.
+func sizeGuardedToolResult(text, hint string) *mcp.CallToolResult {
+	truncated, ok := truncateToMaxResponse(text)
+	if !ok {
+		return mcp.NewToolResultText(text)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(truncationWarning(len(text), hint)),
+			mcp.NewTextContent(truncated),
+		},
+	}
+}
+
+// TruncateResponse applies the response size guard to text returned outside a tool result, such
+// as resource contents. It returns the text, cut to maxResponseBytes if needed, and a warning
+// ending in hint when it was cut.
+func TruncateResponse(text, hint string) (string, string) {
+	truncated, ok := truncateToMaxResponse(text)
+	if !ok {
+		return text, ""
+	}
+	return truncated, truncationWarning(len(text), hint)
+}
+
+// truncateToMaxResponse cuts text to maxResponseBytes, reporting whether it was cut
+func truncateToMaxResponse(text string) (string, bool) {
+	if maxResponseBytes <= 0 || len(text) <= maxResponseBytes {
+		return text, false
+	}
+
+	// Back up to a rune boundary so the truncated text stays valid UTF-8
+	cut := maxResponseBytes
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut], true
+}
+
+// truncationWarning explains that a response of size bytes was truncated, followed by hint
+func truncationWarning(size int, hint string) string {
+	return fmt.Sprintf("WARNING: response was %d bytes and has been truncated to %d bytes. %s", size, maxResponseBytes, hint)
 }
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSizeGuardedToolResult(t *testing.T) {
+	original := maxResponseBytes
+	t.Cleanup(func() { SetMaxResponseBytes(original) })
+
+	t.Run("under threshold", func(t *testing.T) {
+		SetMaxResponseBytes(10)
+		result := sizeGuardedToolResult("small", listTruncationHint)
+		if len(result.Content) != 1 {
+			t.Fatalf("expected 1 content item, got %d", len(result.Content))
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; text != "small" {
+			t.Errorf("expected untouched text, got %q", text)
+		}
+	})
+
+	t.Run("over threshold", func(t *testing.T) {
+		SetMaxResponseBytes(10)
+		result := sizeGuardedToolResult(strings.Repeat("x", 50), listTruncationHint)
+		if len(result.Content) != 2 {
+			t.Fatalf("expected warning and truncated content, got %d items", len(result.Content))
+		}
+		if warning := result.Content[0].(mcp.TextContent).Text; !strings.Contains(warning, "truncated") {
+			t.Errorf("expected truncation warning, got %q", warning)
+		}
+		if text := result.Content[1].(mcp.TextContent).Text; len(text) != 10 {
+			t.Errorf("expected 10 bytes, got %d", len(text))
+		}
+	})
+
+	t.Run("truncates on rune boundary", func(t *testing.T) {
+		SetMaxResponseBytes(4)
+		result := sizeGuardedToolResult("aaa→bbb", listTruncationHint) // → is 3 bytes starting at index 3
+		if text := result.Content[1].(mcp.TextContent).Text; text != "aaa" || !utf8.ValidString(text) {
+			t.Errorf("expected valid UTF-8 prefix 'aaa', got %q", text)
+		}
+	})
+
+	t.Run("per-caller hint", func(t *testing.T) {
+		SetMaxResponseBytes(10)
+		result := sizeGuardedToolResult(strings.Repeat("log line\n", 5), logTruncationHint)
+		warning := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(warning, "sinceTime") || strings.Contains(warning, "JSON") || strings.Contains(warning, "labelSelector") {
+			t.Errorf("expected the log hint, got %q", warning)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		SetMaxResponseBytes(0)
+		result := sizeGuardedToolResult(strings.Repeat("x", 50), listTruncationHint)
+		if len(result.Content) != 1 {
+			t.Errorf("expected no truncation when disabled, got %d items", len(result.Content))
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// countPageSize is the page size used when paging through metadata-only lists to count them
+const countPageSize = 500
+
+type countK8sResourcesParams struct {
+	Context       string
+	Namespace     string
+	AllNamespaces bool
+	Group         string
+	Version       string
+	Kind          string
+	FieldSelector string
+	LabelSelector string
+}
+
+// ResourceCountResult reports how many resources matched, optionally broken down by namespace
+type ResourceCountResult struct {
+	Kind        string         `json:"kind"`
+	Namespace   string         `json:"namespace,omitempty"`
+	Count       int            `json:"count"`
+	ByNamespace map[string]int `json:"byNamespace,omitempty"`
+}
+
+func RegisterCountK8sResourcesMCPTool(s *server.MCPServer) {
+	addTool(s, newCountK8sResourcesMCPTool(), countK8sResourcesHandler)
+}
+
+// Tool schema
+func newCountK8sResourcesMCPTool() mcp.Tool {
+	return mcp.NewTool("count_k8s_resources", readOnlyToolOptions(
+		mcp.WithDescription("Count Kubernetes resources matching a kind and optional namespace/selectors without returning them. "+
+			"Uses metadata-only lists, so it is cheap even for thousands of objects (e.g. 'how many pods are failing across the cluster?')."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to use. Defaults to the context's configured namespace, or all namespaces if the context doesn't set one. Ignored for cluster-scoped resources."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("Count across all namespaces, ignoring the context's default namespace. Results include a per-namespace breakdown. Cannot be used with namespace."),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The Kubernetes resource Kind."),
+			mcp.Required(),
+		),
+		mcp.WithString(fieldSelectorProperty,
+			mcp.Description("Field selector to filter resources server-side (e.g. 'status.phase=Failed'). Only metadata.name and metadata.namespace are supported for every type."),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Label selector to filter resources server-side (e.g. 'app=web')."),
+		),
+	)...)
+}
+
+// Tool handler
+func countK8sResourcesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractCountK8sResourcesParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   params.Group,
+		Version: params.Version,
+		Kind:    params.Kind,
+	}
+
+	// Resolve the resource and its scope
+	mapping, err := k8s.GVKToRESTMapping(params.Context, gvk)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	gvk = mapping.GroupVersionKind
+
+	namespace, err := resolveNamespace(params.Context, mapping, params.Namespace, params.AllNamespaces)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get metadata client
+	metadataClient, err := k8s.GetMetadataClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create metadata client: %v", err)), nil
+	}
+
+	listOptions := metav1.ListOptions{
+		FieldSelector: params.FieldSelector,
+		LabelSelector: params.LabelSelector,
+	}
+	byNamespace, err := countResources(ctx, metadataClient, mapping.Resource, namespace, listOptions)
+	if err != nil {
+		if params.FieldSelector != "" && apierrors.IsBadRequest(err) {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to count resources: %v. %s", err, unsupportedFieldSelectorHint)), nil
+		}
+		return mcp.NewToolResultError(categorizeK8sError("list", mapping.Resource, namespace, "", err).Error()), nil
+	}
+
+	result := ResourceCountResult{
+		Kind:      mapping.GroupVersionKind.Kind,
+		Namespace: namespace,
+	}
+	for _, count := range byNamespace {
+		result.Count += count
+	}
+
+	// Break down by namespace only when counting a namespaced kind across namespaces
+	if namespace == metav1.NamespaceAll && mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		result.ByNamespace = byNamespace
+	}
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+// countResources pages through a metadata-only list, returning matching counts keyed by namespace
+// (the empty key for cluster-scoped resources)
+func countResources(ctx context.Context, metadataClient metadata.Interface, gvr schema.GroupVersionResource, namespace string, listOptions metav1.ListOptions) (map[string]int, error) {
+	counts := map[string]int{}
+	listOptions.Limit = countPageSize
+
+	for {
+		list, err := metadataClient.Resource(gvr).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range list.Items {
+			counts[item.Namespace]++
+		}
+
+		if list.Continue == "" {
+			return counts, nil
+		}
+		listOptions.Continue = list.Continue
+	}
+}
+
+func extractCountK8sResourcesParams(request mcp.CallToolRequest) (*countK8sResourcesParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	fieldSelector, err := normalizeFieldSelector(request.GetString(fieldSelectorProperty, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	return &countK8sResourcesParams{
+		Context:       context,
+		Namespace:     namespace,
+		AllNamespaces: allNamespaces,
+		Group:         request.GetString(groupProperty, ""),
+		Version:       request.GetString(versionProperty, ""),
+		Kind:          kind,
+		FieldSelector: fieldSelector,
+		LabelSelector: request.GetString(labelSelectorProperty, ""),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+func TestCountResources(t *testing.T) {
+	newPod := func(namespace, name string) runtime.Object {
+		return &metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+	}
+	scheme := metadatafake.NewTestScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	client := metadatafake.NewSimpleMetadataClient(scheme,
+		newPod("default", "a"),
+		newPod("default", "b"),
+		newPod("kube-system", "c"),
+	)
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      map[string]int
+	}{
+		{name: "all namespaces", namespace: "", want: map[string]int{"default": 2, "kube-system": 1}},
+		{name: "single namespace", namespace: "default", want: map[string]int{"default": 2}},
+		{name: "empty namespace", namespace: "monitoring", want: map[string]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := countResources(context.Background(), client, gvr, tt.namespace, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package tools
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// categorizeK8sError turns Kubernetes API errors into actionable messages that distinguish a
+// missing resource from an RBAC denial or rejected credentials. verb is the API verb attempted
+// (e.g. "get", "list"); name and namespace may be empty for lists and cluster-scoped requests.
+func categorizeK8sError(verb string, gvr schema.GroupVersionResource, namespace, name string, err error) error {
+	resource := gvr.GroupResource().String()
+	target := resource
+	if name != "" {
+		target = fmt.Sprintf("%s '%s'", resource, name)
+	}
+	scope := "cluster-wide"
+	if namespace != "" {
+		scope = fmt.Sprintf("in namespace '%s'", namespace)
+	}
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Errorf("resource not found: %s %s does not exist. Check the name and namespace, or use list_k8s_resources to find it: %w", target, scope, err)
+	case apierrors.IsForbidden(err):
+		return fmt.Errorf("forbidden: your context lacks '%s' permission on %s %s. This is an RBAC restriction, not a missing resource: %w", verb, resource, scope, err)
+	case apierrors.IsUnauthorized(err):
+		return fmt.Errorf("unauthorized: the credentials for this context were rejected or have expired. Re-authenticate (e.g. refresh the kubeconfig token) and retry: %w", err)
+	default:
+		return fmt.Errorf("failed to %s %s: %w", verb, resource, k8s.ClassifyClusterError(err))
+	}
+}
@@ -0,0 +1,72 @@
+package tools
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCategorizeK8sError(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	groupResource := gvr.GroupResource()
+
+	tests := []struct {
+		name      string
+		verb      string
+		namespace string
+		resource  string
+		err       error
+		want      []string
+	}{
+		{
+			name:      "not found",
+			verb:      "get",
+			namespace: "default",
+			resource:  "web",
+			err:       apierrors.NewNotFound(groupResource, "web"),
+			want:      []string{"resource not found", "deployments.apps 'web'", "namespace 'default'"},
+		},
+		{
+			name:      "forbidden",
+			verb:      "list",
+			namespace: "kube-system",
+			err:       apierrors.NewForbidden(groupResource, "", errors.New("RBAC denied")),
+			want:      []string{"forbidden", "'list' permission on deployments.apps", "namespace 'kube-system'"},
+		},
+		{
+			name: "forbidden cluster-wide",
+			verb: "list",
+			err:  apierrors.NewForbidden(groupResource, "", errors.New("RBAC denied")),
+			want: []string{"forbidden", "cluster-wide"},
+		},
+		{
+			name: "unauthorized",
+			verb: "get",
+			err:  apierrors.NewUnauthorized("token expired"),
+			want: []string{"unauthorized", "Re-authenticate"},
+		},
+		{
+			name: "other error",
+			verb: "list",
+			err:  errors.New("connection refused"),
+			want: []string{"failed to list deployments.apps", "connection refused"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := categorizeK8sError(tt.verb, gvr, tt.namespace, tt.resource, tt.err)
+			if !errors.Is(got, tt.err) {
+				t.Errorf("expected wrapped error to match original")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got.Error(), want) {
+					t.Errorf("expected %q to contain %q", got.Error(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// AggregatedEvent is one unique event (type, reason and involved object) with its occurrences
+// summed across the individual Event objects, like the deduplicated view of kubectl get events
+type AggregatedEvent struct {
+	Type           string `json:"type,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	InvolvedObject string `json:"involvedObject,omitempty"`
+	Namespace      string `json:"namespace,omitempty"`
+	Count          int64  `json:"count"`
+	FirstSeen      string `json:"firstSeen,omitempty"`
+	LastSeen       string `json:"lastSeen,omitempty"`
+	Message        string `json:"message,omitempty"` // Most recent message
+}
+
+type eventAggregationKey struct {
+	eventType      string
+	reason         string
+	namespace      string
+	involvedObject string
+}
+
+// aggregateEvents groups core/v1 and events.k8s.io Events by type, reason and involved object,
+// summing counts and tracking first/last seen. Results are ordered by most recently seen.
+func aggregateEvents(items []unstructured.Unstructured) []AggregatedEvent {
+	groups := map[eventAggregationKey]*AggregatedEvent{}
+	firstSeen := map[eventAggregationKey]time.Time{}
+	lastSeen := map[eventAggregationKey]time.Time{}
+	var order []eventAggregationKey
+
+	for _, item := range items {
+		eventType, _, _ := unstructured.NestedString(item.Object, "type")
+		reason, _, _ := unstructured.NestedString(item.Object, "reason")
+		key := eventAggregationKey{
+			eventType:      eventType,
+			reason:         reason,
+			namespace:      item.GetNamespace(),
+			involvedObject: eventInvolvedObject(item),
+		}
+
+		group, exists := groups[key]
+		if !exists {
+			group = &AggregatedEvent{
+				Type:           key.eventType,
+				Reason:         key.reason,
+				InvolvedObject: key.involvedObject,
+				Namespace:      key.namespace,
+			}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Count += eventCount(item)
+
+		first, last := eventTimes(item)
+		if !first.IsZero() && (firstSeen[key].IsZero() || first.Before(firstSeen[key])) {
+			firstSeen[key] = first
+			group.FirstSeen = first.Format(time.RFC3339)
+		}
+		// The latest occurrence's message describes the current state best
+		if !last.IsZero() && !last.Before(lastSeen[key]) {
+			lastSeen[key] = last
+			group.LastSeen = last.Format(time.RFC3339)
+			group.Message = eventMessage(item)
+		} else if group.Message == "" {
+			group.Message = eventMessage(item)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return lastSeen[order[i]].After(lastSeen[order[j]])
+	})
+
+	aggregated := make([]AggregatedEvent, 0, len(order))
+	for _, key := range order {
+		aggregated = append(aggregated, *groups[key])
+	}
+	return aggregated
+}
+
+// eventInvolvedObject formats the object an event is about as kind/name, reading involvedObject
+// (core/v1) or regarding (events.k8s.io/v1)
+func eventInvolvedObject(item unstructured.Unstructured) string {
+	object, found, _ := unstructured.NestedMap(item.Object, "involvedObject")
+	if !found {
+		object, _, _ = unstructured.NestedMap(item.Object, "regarding")
+	}
+	kind, _, _ := unstructured.NestedString(object, "kind")
+	name, _, _ := unstructured.NestedString(object, "name")
+	if kind == "" {
+		return name
+	}
+	return kind + "/" + name
+}
+
+// eventCount returns how many times an event occurred, treating events without a count as one occurrence
+func eventCount(item unstructured.Unstructured) int64 {
+	for _, path := range [][]string{{"count"}, {"series", "count"}, {"deprecatedCount"}} {
+		if count, found, _ := unstructured.NestedInt64(item.Object, path...); found && count > 0 {
+			return count
+		}
+	}
+	return 1
+}
+
+// eventTimes returns an event's first and last occurrence, falling back to eventTime and
+// creationTimestamp when the legacy timestamps are unset
+func eventTimes(item unstructured.Unstructured) (first, last time.Time) {
+	first = parseEventTime(item, []string{"firstTimestamp"}, []string{"deprecatedFirstTimestamp"}, []string{"eventTime"})
+	last = parseEventTime(item, []string{"lastTimestamp"}, []string{"deprecatedLastTimestamp"}, []string{"series", "lastObservedTime"}, []string{"eventTime"})
+	if created := item.GetCreationTimestamp(); !created.IsZero() {
+		if first.IsZero() {
+			first = created.Time
+		}
+		if last.IsZero() {
+			last = created.Time
+		}
+	}
+	return first, last
+}
+
+func parseEventTime(item unstructured.Unstructured, paths ...[]string) time.Time {
+	for _, path := range paths {
+		value, found, _ := unstructured.NestedString(item.Object, path...)
+		if !found || value == "" {
+			continue
+		}
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
+
+func eventMessage(item unstructured.Unstructured) string {
+	if message, found, _ := unstructured.NestedString(item.Object, "message"); found {
+		return strings.TrimSpace(message)
+	}
+	// events.k8s.io uses 'note' instead of 'message'
+	note, _, _ := unstructured.NestedString(item.Object, "note")
+	return strings.TrimSpace(note)
+}
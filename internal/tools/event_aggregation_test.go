@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAggregateEvents(t *testing.T) {
+	newEvent := func(eventType, reason, podName, message, first, last string, count int64) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"metadata":       map[string]any{"name": podName + "." + reason, "namespace": "default"},
+			"type":           eventType,
+			"reason":         reason,
+			"message":        message,
+			"involvedObject": map[string]any{"kind": "Pod", "name": podName},
+			"count":          count,
+			"firstTimestamp": first,
+			"lastTimestamp":  last,
+		}}
+	}
+	items := []unstructured.Unstructured{
+		newEvent("Warning", "BackOff", "web-1", "Back-off restarting failed container (1)", "2025-06-01T10:00:00Z", "2025-06-01T10:05:00Z", 3),
+		newEvent("Normal", "Pulled", "web-1", "Successfully pulled image", "2025-06-01T09:00:00Z", "2025-06-01T09:00:00Z", 1),
+		newEvent("Warning", "BackOff", "web-1", "Back-off restarting failed container (2)", "2025-06-01T10:10:00Z", "2025-06-01T11:00:00Z", 4),
+		// events.k8s.io/v1 shape with regarding, note, and series
+		{Object: map[string]any{
+			"metadata":  map[string]any{"name": "api-1.FailedMount", "namespace": "default"},
+			"type":      "Warning",
+			"reason":    "FailedMount",
+			"note":      "MountVolume.SetUp failed",
+			"regarding": map[string]any{"kind": "Pod", "name": "api-1"},
+			"eventTime": "2025-06-01T08:00:00.000000Z",
+			"series":    map[string]any{"count": int64(2), "lastObservedTime": "2025-06-01T08:30:00.000000Z"},
+		}},
+	}
+
+	got := aggregateEvents(items)
+	want := []AggregatedEvent{
+		{
+			Type: "Warning", Reason: "BackOff", InvolvedObject: "Pod/web-1", Namespace: "default", Count: 7,
+			FirstSeen: "2025-06-01T10:00:00Z", LastSeen: "2025-06-01T11:00:00Z", Message: "Back-off restarting failed container (2)",
+		},
+		{
+			Type: "Normal", Reason: "Pulled", InvolvedObject: "Pod/web-1", Namespace: "default", Count: 1,
+			FirstSeen: "2025-06-01T09:00:00Z", LastSeen: "2025-06-01T09:00:00Z", Message: "Successfully pulled image",
+		},
+		{
+			Type: "Warning", Reason: "FailedMount", InvolvedObject: "Pod/api-1", Namespace: "default", Count: 2,
+			FirstSeen: "2025-06-01T08:00:00Z", LastSeen: "2025-06-01T08:30:00Z", Message: "MountVolume.SetUp failed",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
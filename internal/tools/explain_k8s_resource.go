@@ -0,0 +1,322 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+const (
+	pathProperty = "path"
+
+	openAPISchemaRefPrefix = "#/components/schemas/"
+)
+
+type explainK8sResourceParams struct {
+	Context string
+	Group   string
+	Version string
+	Kind    string
+	Path    string
+}
+
+// ExplainResult describes a resource type or one of its fields, similar to kubectl explain
+type ExplainResult struct {
+	Kind        string         `json:"kind"`
+	APIVersion  string         `json:"apiVersion"`
+	Path        string         `json:"path"`
+	Type        string         `json:"type,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Fields      []ExplainField `json:"fields,omitempty"`
+}
+
+// ExplainField describes a single field of an object schema
+type ExplainField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// openAPIDocument is the subset of an OpenAPI v3 document needed for explaining fields
+type openAPIDocument struct {
+	Components struct {
+		Schemas map[string]map[string]any `json:"schemas"`
+	} `json:"components"`
+}
+
+func RegisterExplainK8sResourceMCPTool(s *server.MCPServer) {
+	addTool(s, newExplainK8sResourceMCPTool(), explainK8sResourceHandler)
+}
+
+// Tool schema
+func newExplainK8sResourceMCPTool() mcp.Tool {
+	return mcp.NewTool("explain_k8s_resource", readOnlyToolOptions(
+		mcp.WithDescription("Describe the fields of a Kubernetes resource type using the cluster's OpenAPI schema (equivalent to `kubectl explain`). Works for built-in types and CRDs."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The Kubernetes resource Kind."),
+			mcp.Required(),
+		),
+		mcp.WithString(pathProperty,
+			mcp.Description("Optional dot-separated field path to explain (e.g., 'spec.strategy' or 'deployment.spec.strategy'). Defaults to the top-level resource."),
+		),
+	)...)
+}
+
+// Tool handler
+func explainK8sResourceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractExplainK8sResourceParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get discovery client
+	discoveryClient, err := k8s.GetDiscoveryClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create discovery client: %v", err)), nil
+	}
+
+	// Resolve the served group/version, falling back to the preferred version
+	mapping, err := k8s.GVKToRESTMapping(params.Context, schema.GroupVersionKind{Group: params.Group, Version: params.Version, Kind: params.Kind})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	gv := mapping.GroupVersionKind.GroupVersion()
+
+	// Find the OpenAPI v3 document for the group/version
+	openAPIPath := "apis/" + gv.String()
+	if gv.Group == "" {
+		openAPIPath = "api/" + gv.Version
+	}
+
+	paths, err := discoveryClient.OpenAPIV3().Paths()
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get OpenAPI paths: %v", err)), nil
+	}
+
+	groupVersion, ok := paths[openAPIPath]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("No OpenAPI schema found for %s. Use list_k8s_api_resources to discover available groups and versions.", gv.String())), nil
+	}
+
+	schemaBytes, err := groupVersion.Schema("application/json")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get OpenAPI schema: %v", err)), nil
+	}
+
+	var doc openAPIDocument
+	if err := json.Unmarshal(schemaBytes, &doc); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to parse OpenAPI schema: %v", err)), nil
+	}
+
+	result, err := explainSchema(&doc, mapping.GroupVersionKind, params.Path)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+func extractExplainK8sResourceParams(request mcp.CallToolRequest) (*explainK8sResourceParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &explainK8sResourceParams{
+		Context: context,
+		Group:   request.GetString(groupProperty, ""),
+		Version: request.GetString(versionProperty, ""),
+		Kind:    kind,
+		Path:    request.GetString(pathProperty, ""),
+	}, nil
+}
+
+// explainSchema finds the schema for a GVK and walks the field path within it
+func explainSchema(doc *openAPIDocument, gvk schema.GroupVersionKind, path string) (*ExplainResult, error) {
+	kindSchema, kind := findKindSchema(doc, gvk)
+	if kindSchema == nil {
+		return nil, fmt.Errorf("no schema found for kind %s in %s", gvk.Kind, gvk.GroupVersion().String())
+	}
+
+	// Allow paths prefixed with the kind, like kubectl explain
+	var fields []string
+	if path != "" {
+		fields = strings.Split(path, ".")
+		if strings.EqualFold(fields[0], kind) {
+			fields = fields[1:]
+		}
+	}
+
+	current := resolveSchema(doc, kindSchema)
+	description, _ := current["description"].(string)
+	fieldType := schemaTypeName(kindSchema)
+
+	for i, field := range fields {
+		// Step through arrays and maps to their element schema
+		current = elementSchema(doc, current)
+
+		properties, _ := current["properties"].(map[string]any)
+		fieldSchema, ok := properties[field].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("field %q does not exist in %s", field, strings.Join(append([]string{kind}, fields[:i]...), "."))
+		}
+
+		fieldType = schemaTypeName(fieldSchema)
+		current = resolveSchema(doc, fieldSchema)
+		// Prefer the field's own description over the referenced type's description
+		if d, ok := fieldSchema["description"].(string); ok {
+			description = d
+		} else {
+			description, _ = current["description"].(string)
+		}
+	}
+
+	result := &ExplainResult{
+		Kind:        kind,
+		APIVersion:  gvk.GroupVersion().String(),
+		Path:        strings.Join(append([]string{kind}, fields...), "."),
+		Type:        fieldType,
+		Description: description,
+	}
+
+	// List the fields of the (element) object
+	current = elementSchema(doc, current)
+	properties, _ := current["properties"].(map[string]any)
+	required := map[string]bool{}
+	if requiredFields, ok := current["required"].([]any); ok {
+		for _, r := range requiredFields {
+			if name, ok := r.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+	for name, p := range properties {
+		fieldSchema, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		field := ExplainField{
+			Name:     name,
+			Type:     schemaTypeName(fieldSchema),
+			Required: required[name],
+		}
+		if d, ok := fieldSchema["description"].(string); ok {
+			field.Description = d
+		} else {
+			field.Description, _ = resolveSchema(doc, fieldSchema)["description"].(string)
+		}
+		result.Fields = append(result.Fields, field)
+	}
+	sort.Slice(result.Fields, func(i, j int) bool {
+		return result.Fields[i].Name < result.Fields[j].Name
+	})
+
+	return result, nil
+}
+
+// findKindSchema finds the schema tagged with the given GVK, matching the Kind case-insensitively
+func findKindSchema(doc *openAPIDocument, gvk schema.GroupVersionKind) (map[string]any, string) {
+	for _, s := range doc.Components.Schemas {
+		gvks, _ := s["x-kubernetes-group-version-kind"].([]any)
+		for _, g := range gvks {
+			gvkMap, ok := g.(map[string]any)
+			if !ok {
+				continue
+			}
+			group, _ := gvkMap["group"].(string)
+			version, _ := gvkMap["version"].(string)
+			kind, _ := gvkMap["kind"].(string)
+			if group == gvk.Group && version == gvk.Version && strings.EqualFold(kind, gvk.Kind) {
+				return s, kind
+			}
+		}
+	}
+	return nil, ""
+}
+
+// resolveSchema follows a $ref, either direct or wrapped in a single-element allOf
+func resolveSchema(doc *openAPIDocument, s map[string]any) map[string]any {
+	if ref := schemaRef(s); ref != "" {
+		if resolved, ok := doc.Components.Schemas[ref]; ok {
+			return resolved
+		}
+	}
+	return s
+}
+
+// elementSchema returns the resolved item schema for arrays and maps, or the schema itself
+func elementSchema(doc *openAPIDocument, s map[string]any) map[string]any {
+	if items, ok := s["items"].(map[string]any); ok {
+		return resolveSchema(doc, items)
+	}
+	if additional, ok := s["additionalProperties"].(map[string]any); ok {
+		return resolveSchema(doc, additional)
+	}
+	return s
+}
+
+// schemaRef returns the referenced schema name, if any
+func schemaRef(s map[string]any) string {
+	if ref, ok := s["$ref"].(string); ok {
+		return strings.TrimPrefix(ref, openAPISchemaRefPrefix)
+	}
+	if allOf, ok := s["allOf"].([]any); ok && len(allOf) == 1 {
+		if inner, ok := allOf[0].(map[string]any); ok {
+			return schemaRef(inner)
+		}
+	}
+	return ""
+}
+
+// schemaTypeName renders a short, kubectl explain style type name
+func schemaTypeName(s map[string]any) string {
+	if ref := schemaRef(s); ref != "" {
+		// Use the last segment of the definition name, e.g. io.k8s.api.apps.v1.DeploymentSpec -> DeploymentSpec
+		return "<" + ref[strings.LastIndex(ref, ".")+1:] + ">"
+	}
+	switch t, _ := s["type"].(string); t {
+	case "array":
+		if items, ok := s["items"].(map[string]any); ok {
+			return "[]" + schemaTypeName(items)
+		}
+		return "[]"
+	case "object":
+		if additional, ok := s["additionalProperties"].(map[string]any); ok {
+			return "map[string]" + schemaTypeName(additional)
+		}
+		return "object"
+	case "":
+		if _, ok := s["x-kubernetes-int-or-string"]; ok {
+			return "int-or-string"
+		}
+		return ""
+	default:
+		return t
+	}
+}
@@ -0,0 +1,137 @@
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testOpenAPIDocument = `{
+  "components": {
+    "schemas": {
+      "io.k8s.api.apps.v1.Deployment": {
+        "description": "Deployment enables declarative updates for Pods and ReplicaSets.",
+        "type": "object",
+        "properties": {
+          "spec": {
+            "allOf": [{"$ref": "#/components/schemas/io.k8s.api.apps.v1.DeploymentSpec"}],
+            "description": "Specification of the desired behavior of the Deployment."
+          }
+        },
+        "x-kubernetes-group-version-kind": [{"group": "apps", "kind": "Deployment", "version": "v1"}]
+      },
+      "io.k8s.api.apps.v1.DeploymentSpec": {
+        "description": "DeploymentSpec is the specification of the desired behavior of the Deployment.",
+        "type": "object",
+        "required": ["selector"],
+        "properties": {
+          "replicas": {"type": "integer", "description": "Number of desired pods."},
+          "selector": {"allOf": [{"$ref": "#/components/schemas/io.k8s.LabelSelector"}], "description": "Label selector for pods."},
+          "strategy": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.apps.v1.DeploymentStrategy"}], "description": "The deployment strategy."},
+          "ports": {"type": "array", "items": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.apps.v1.Port"}]}}
+        }
+      },
+      "io.k8s.api.apps.v1.DeploymentStrategy": {
+        "description": "DeploymentStrategy describes how to replace existing pods with new ones.",
+        "type": "object",
+        "properties": {
+          "type": {"type": "string", "description": "Type of deployment."}
+        }
+      },
+      "io.k8s.api.apps.v1.Port": {
+        "type": "object",
+        "properties": {
+          "port": {"type": "integer", "description": "The port number."}
+        }
+      },
+      "io.k8s.LabelSelector": {
+        "type": "object",
+        "properties": {
+          "matchLabels": {"type": "object", "additionalProperties": {"type": "string"}}
+        }
+      }
+    }
+  }
+}`
+
+func TestExplainSchema(t *testing.T) {
+	var doc openAPIDocument
+	if err := json.Unmarshal([]byte(testOpenAPIDocument), &doc); err != nil {
+		t.Fatalf("failed to parse test document: %v", err)
+	}
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "deployment"}
+
+	t.Run("top level", func(t *testing.T) {
+		result, err := explainSchema(&doc, gvk, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Kind != "Deployment" || result.Path != "Deployment" {
+			t.Errorf("Kind/Path = %s/%s, want Deployment/Deployment", result.Kind, result.Path)
+		}
+		if len(result.Fields) != 1 || result.Fields[0].Name != "spec" || result.Fields[0].Type != "<DeploymentSpec>" {
+			t.Errorf("unexpected fields: %+v", result.Fields)
+		}
+	})
+
+	t.Run("nested path with kind prefix", func(t *testing.T) {
+		result, err := explainSchema(&doc, gvk, "deployment.spec.strategy")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Type != "<DeploymentStrategy>" || result.Description != "The deployment strategy." {
+			t.Errorf("Type/Description = %q/%q", result.Type, result.Description)
+		}
+		if len(result.Fields) != 1 || result.Fields[0].Name != "type" || result.Fields[0].Type != "string" {
+			t.Errorf("unexpected fields: %+v", result.Fields)
+		}
+	})
+
+	t.Run("required fields and type names", func(t *testing.T) {
+		result, err := explainSchema(&doc, gvk, "spec")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		types := map[string]string{}
+		for _, f := range result.Fields {
+			types[f.Name] = f.Type
+			if f.Name == "selector" && !f.Required {
+				t.Errorf("selector should be required")
+			}
+		}
+		if types["ports"] != "[]<Port>" || types["replicas"] != "integer" {
+			t.Errorf("unexpected field types: %v", types)
+		}
+	})
+
+	t.Run("through arrays and maps", func(t *testing.T) {
+		result, err := explainSchema(&doc, gvk, "spec.ports.port")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Type != "integer" {
+			t.Errorf("Type = %q, want integer", result.Type)
+		}
+
+		result, err = explainSchema(&doc, gvk, "spec.selector.matchLabels")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Type != "map[string]string" {
+			t.Errorf("Type = %q, want map[string]string", result.Type)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		if _, err := explainSchema(&doc, gvk, "spec.missing"); err == nil {
+			t.Error("expected error for unknown field")
+		}
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		if _, err := explainSchema(&doc, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Missing"}, ""); err == nil {
+			t.Error("expected error for unknown kind")
+		}
+	})
+}
@@ -0,0 +1,269 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+	"github.com/krmcbride/mcp-k8s/internal/tools/mapper"
+)
+
+// hpaGVR is the HorizontalPodAutoscaler API version that supports multiple metrics and conditions
+var hpaGVR = schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}
+
+type getK8sHPAStatusParams struct {
+	Context   string
+	Namespace string
+	Name      string
+}
+
+// HPAStatusResult combines an HPA with the scale of its target and the current usage of the target's pods
+type HPAStatusResult struct {
+	HPA           mapper.HorizontalPodAutoscalerListContent `json:"hpa"`
+	Target        *mapper.ScaleContent                      `json:"target,omitempty"`        // Desired vs current replicas of the scale target
+	DrivingMetric *HPADrivingMetric                         `json:"drivingMetric,omitempty"` // Metric currently determining the desired replica count
+	PodMetrics    []PodMetrics                              `json:"podMetrics,omitempty"`    // Usage of the target's pods, with a TOTAL entry
+	Hint          string                                    `json:"hint,omitempty"`
+	Warnings      []string                                  `json:"warnings,omitempty"` // Parts that couldn't be fetched, e.g. when metrics-server is missing
+}
+
+// HPADrivingMetric is the metric with the highest current-to-target ratio. The HPA computes a replica
+// count for every metric and uses the largest, so this is the one driving scaling decisions.
+type HPADrivingMetric struct {
+	mapper.HPAMetric
+	Ratio float64 `json:"ratio"` // Current divided by target; above 1 scales up, below 1 scales down
+}
+
+func RegisterGetK8sHPAStatusMCPTool(s *server.MCPServer) {
+	addTool(s, newGetK8sHPAStatusMCPTool(), getK8sHPAStatusHandler)
+}
+
+// Tool schema
+func newGetK8sHPAStatusMCPTool() mcp.Tool {
+	return mcp.NewTool("get_k8s_hpa_status", readOnlyToolOptions(
+		mcp.WithDescription("Debug a HorizontalPodAutoscaler in one call: returns the HPA's metrics (target vs current) and conditions, "+
+			"the desired vs current replicas of its scale target (e.g. a Deployment or StatefulSet), the metric currently driving scaling, "+
+			"and current CPU/memory usage of the target's pods. Answers 'why isn't my HPA scaling?'"),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace of the HorizontalPodAutoscaler."),
+			mcp.Required(),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("The name of the HorizontalPodAutoscaler."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func getK8sHPAStatusHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractGetK8sHPAStatusParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	// Get the HPA
+	hpa, err := getK8sResource(ctx, dynamicClient, hpaGVR, params.Namespace, params.Name)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("get", hpaGVR, params.Namespace, params.Name, err).Error()), nil
+	}
+
+	result := &HPAStatusResult{}
+	if mapHPA, ok := mapper.Get(hpa.GroupVersionKind()); ok {
+		result.HPA, _ = mapHPA(*hpa).(mapper.HorizontalPodAutoscalerListContent)
+	}
+	result.DrivingMetric = drivingHPAMetric(result.HPA.Metrics)
+
+	// Get the scale of the target workload. Failures from here on are reported as warnings so
+	// the HPA's own status is still returned.
+	target, err := getHPATargetScale(ctx, params.Context, hpa)
+	if err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("failed to get scale target %s: %v", result.HPA.Target, err))
+	} else {
+		result.Target = target
+	}
+
+	// Get current usage of the target's pods
+	if target != nil && target.Selector != "" {
+		podMetrics, err := getHPATargetPodMetrics(ctx, params.Context, params.Namespace, target.Selector)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to get pod metrics: %v", err))
+		} else {
+			result.PodMetrics = podMetrics
+		}
+	}
+
+	result.Hint = hpaStatusHint(result)
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+func extractGetK8sHPAStatusParams(request mcp.CallToolRequest) (*getK8sHPAStatusParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := request.RequireString(nameProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getK8sHPAStatusParams{
+		Context:   context,
+		Namespace: namespace,
+		Name:      name,
+	}, nil
+}
+
+// getHPATargetScale reads the scale subresource of the HPA's scale target, which works for any
+// scalable kind (Deployment, StatefulSet, ReplicaSet, or a custom resource)
+func getHPATargetScale(ctx context.Context, k8sContext string, hpa *unstructured.Unstructured) (*mapper.ScaleContent, error) {
+	apiVersion, _, _ := unstructured.NestedString(hpa.Object, "spec", "scaleTargetRef", "apiVersion")
+	kind, _, _ := unstructured.NestedString(hpa.Object, "spec", "scaleTargetRef", "kind")
+	name, _, _ := unstructured.NestedString(hpa.Object, "spec", "scaleTargetRef", "name")
+	if kind == "" || name == "" {
+		return nil, fmt.Errorf("scaleTargetRef is incomplete")
+	}
+
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scaleTargetRef apiVersion %q: %w", apiVersion, err)
+	}
+
+	gvr, err := k8s.GVKToGVR(k8sContext, gv.WithKind(kind))
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := k8s.GetDynamicClientForContext(k8sContext)
+	if err != nil {
+		return nil, err
+	}
+
+	scale, err := getK8sResource(ctx, dynamicClient, gvr, hpa.GetNamespace(), name, "scale")
+	if err != nil {
+		return nil, categorizeK8sError("get", gvr, hpa.GetNamespace(), name, err)
+	}
+
+	content, ok := mapScale(*scale)
+	if !ok {
+		return nil, fmt.Errorf("unexpected scale content for %s/%s", kind, name)
+	}
+	return &content, nil
+}
+
+// mapScale maps a scale subresource using the registered Scale mapper
+func mapScale(scale unstructured.Unstructured) (mapper.ScaleContent, bool) {
+	mapScaleResource, ok := mapper.Get(scaleGVK)
+	if !ok {
+		return mapper.ScaleContent{}, false
+	}
+	content, ok := mapScaleResource(scale).(mapper.ScaleContent)
+	return content, ok
+}
+
+// getHPATargetPodMetrics lists metrics for the pods matched by the scale target's selector, with a TOTAL entry
+func getHPATargetPodMetrics(ctx context.Context, k8sContext, namespace, selector string) ([]PodMetrics, error) {
+	// Preflight check so a missing metrics-server isn't reported as a generic failure
+	if available, err := k8s.IsMetricsAPIAvailable(k8sContext); err == nil && !available {
+		return nil, fmt.Errorf("metrics-server not available on this cluster (the metrics.k8s.io API is not registered)")
+	}
+
+	metricsClient, err := k8s.GetMetricsClientForContext(k8sContext)
+	if err != nil {
+		return nil, err
+	}
+
+	podMetrics, _, err := getPodMetrics(ctx, metricsClient, &getK8sMetricsParams{
+		Namespace:     namespace,
+		LabelSelector: selector,
+		Sum:           true,
+	})
+	return podMetrics, err
+}
+
+// drivingHPAMetric returns the metric with the highest current-to-target ratio, or nil when no
+// metric has both a target and a current value that can be compared
+func drivingHPAMetric(metrics []mapper.HPAMetric) *HPADrivingMetric {
+	var driving *HPADrivingMetric
+	for _, metric := range metrics {
+		target, ok := parseHPAMetricValue(metric.Target)
+		if !ok || target == 0 {
+			continue
+		}
+		current, ok := parseHPAMetricValue(metric.Current)
+		if !ok {
+			continue
+		}
+
+		ratio := math.Round(current/target*100) / 100
+		if driving == nil || ratio > driving.Ratio {
+			driving = &HPADrivingMetric{HPAMetric: metric, Ratio: ratio}
+		}
+	}
+	return driving
+}
+
+// parseHPAMetricValue parses a formatted metric value, either a percentage ("80%") or a quantity ("500m")
+func parseHPAMetricValue(value string) (float64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if percent, ok := strings.CutSuffix(value, "%"); ok {
+		value = percent
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, false
+	}
+	return quantity.AsApproximateFloat64(), true
+}
+
+// hpaStatusHint explains the most likely reason an HPA isn't scaling as expected
+func hpaStatusHint(result *HPAStatusResult) string {
+	for _, cond := range result.HPA.Conditions {
+		if cond.Type == "AbleToScale" && cond.Status == "False" {
+			return fmt.Sprintf("The HPA is unable to scale the target (%s): %s", cond.Reason, cond.Message)
+		}
+	}
+	for _, cond := range result.HPA.Conditions {
+		if cond.Type == "ScalingActive" && cond.Status == "False" {
+			return fmt.Sprintf("The HPA cannot compute a replica count (%s): %s. Check that metrics are available for the target's pods and that the pods set resource requests.", cond.Reason, cond.Message)
+		}
+	}
+	for _, cond := range result.HPA.Conditions {
+		if cond.Type == "ScalingLimited" && cond.Status == "True" {
+			return fmt.Sprintf("Scaling is limited (%s): %s", cond.Reason, cond.Message)
+		}
+	}
+	if len(result.HPA.Metrics) > 0 && result.DrivingMetric == nil {
+		return "No current metric values have been observed yet; the HPA may have just been created or metrics may be unavailable."
+	}
+	return ""
+}
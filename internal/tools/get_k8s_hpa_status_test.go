@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/krmcbride/mcp-k8s/internal/tools/mapper"
+)
+
+func TestDrivingHPAMetric(t *testing.T) {
+	tests := []struct {
+		name      string
+		metrics   []mapper.HPAMetric
+		wantName  string
+		wantRatio float64
+	}{
+		{
+			name: "highest ratio wins",
+			metrics: []mapper.HPAMetric{
+				{Type: "Resource", Name: "cpu", Target: "80%", Current: "40%"},
+				{Type: "Resource", Name: "memory", Target: "500Mi", Current: "750Mi"},
+			},
+			wantName:  "memory",
+			wantRatio: 1.5,
+		},
+		{
+			name: "metrics without current values are skipped",
+			metrics: []mapper.HPAMetric{
+				{Type: "External", Name: "queue_depth", Target: "30"},
+				{Type: "Resource", Name: "cpu", Target: "50%", Current: "25%"},
+			},
+			wantName:  "cpu",
+			wantRatio: 0.5,
+		},
+		{
+			name:    "no comparable metrics",
+			metrics: []mapper.HPAMetric{{Type: "Resource", Name: "cpu", Target: "80%"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := drivingHPAMetric(tt.metrics)
+			if tt.wantName == "" {
+				if got != nil {
+					t.Errorf("expected no driving metric, got %+v", got)
+				}
+				return
+			}
+			if got == nil || got.Name != tt.wantName || got.Ratio != tt.wantRatio {
+				t.Errorf("expected %s with ratio %v, got %+v", tt.wantName, tt.wantRatio, got)
+			}
+		})
+	}
+}
+
+func TestHPAStatusHint(t *testing.T) {
+	cpu := []mapper.HPAMetric{{Type: "Resource", Name: "cpu", Target: "80%"}}
+	tests := []struct {
+		name     string
+		result   HPAStatusResult
+		wantHint bool
+	}{
+		{name: "healthy", result: HPAStatusResult{
+			HPA:           mapper.HorizontalPodAutoscalerListContent{Metrics: cpu, Conditions: []mapper.HPACondition{{Type: "ScalingActive", Status: "True"}}},
+			DrivingMetric: &HPADrivingMetric{Ratio: 0.5},
+		}, wantHint: false},
+		{name: "metrics unavailable", result: HPAStatusResult{
+			HPA: mapper.HorizontalPodAutoscalerListContent{Conditions: []mapper.HPACondition{{Type: "ScalingActive", Status: "False", Reason: "FailedGetResourceMetric"}}},
+		}, wantHint: true},
+		{name: "at max replicas", result: HPAStatusResult{
+			HPA:           mapper.HorizontalPodAutoscalerListContent{Conditions: []mapper.HPACondition{{Type: "ScalingLimited", Status: "True", Reason: "TooManyReplicas"}}},
+			DrivingMetric: &HPADrivingMetric{Ratio: 2},
+		}, wantHint: true},
+		{name: "no current values", result: HPAStatusResult{
+			HPA: mapper.HorizontalPodAutoscalerListContent{Metrics: cpu},
+		}, wantHint: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hint := hpaStatusHint(&tt.result)
+			if (hint != "") != tt.wantHint {
+				t.Errorf("hpaStatusHint() = %q, wantHint %t", hint, tt.wantHint)
+			}
+		})
+	}
+}
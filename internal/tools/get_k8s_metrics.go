@@ -3,7 +3,9 @@ package tools
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -15,19 +17,49 @@ import (
 	"github.com/krmcbride/mcp-k8s/internal/k8s"
 )
 
+const (
+	byNamespaceProperty = "byNamespace"
+	sortByProperty      = "sortBy"
+	samplesProperty     = "samples"
+	intervalProperty    = "interval"
+
+	defaultMetricsSampleInterval = 15 * time.Second
+	maxMetricsSamples            = 10
+	maxMetricsSamplingDuration   = 5 * time.Minute
+)
+
+// Supported values for the sortBy property
+const (
+	sortByCPU    = "cpu"
+	sortByMemory = "memory"
+)
+
 type getK8sMetricsParams struct {
-	Context   string
-	Kind      string
-	Namespace string
-	Name      string
-	Sum       bool
+	Context       string
+	Kind          string
+	Namespace     string
+	AllNamespaces bool
+	Name          string
+	LabelSelector string
+	Container     string
+	Sum           bool
+	Limit         int64
+	Continue      string
+	ByNamespace   bool
+	SortBy        string
+	Samples       int
+	Interval      time.Duration
 }
 
 // NodeMetrics represents CPU and memory usage for a node
 type NodeMetrics struct {
-	Name               string `json:"name"`
-	CPUUsageMillicores int64  `json:"cpuUsageMillicores"`
-	MemoryUsageMiB     int64  `json:"memoryUsageMiB"`
+	Name               string  `json:"name"`
+	CPUUsageMillicores int64   `json:"cpuUsageMillicores"`
+	CPUUsageCores      float64 `json:"cpuUsageCores"` // Same usage in cores (e.g. 12.5 for 12500m) for readability
+	MemoryUsageMiB     int64   `json:"memoryUsageMiB"`
+	MemoryUsageBytes   int64   `json:"memoryUsageBytes"`
+	Timestamp          string  `json:"timestamp,omitempty"`     // When the sample was collected
+	WindowSeconds      float64 `json:"windowSeconds,omitempty"` // Interval the usage was averaged over
 }
 
 // PodMetrics represents CPU and memory usage for a pod
@@ -35,25 +67,65 @@ type PodMetrics struct {
 	Name               string             `json:"name"`
 	Namespace          string             `json:"namespace"`
 	CPUUsageMillicores int64              `json:"cpuUsageMillicores"`
+	CPUUsageCores      float64            `json:"cpuUsageCores"` // Same usage in cores for readability
 	MemoryUsageMiB     int64              `json:"memoryUsageMiB"`
+	MemoryUsageBytes   int64              `json:"memoryUsageBytes"`
+	Timestamp          string             `json:"timestamp,omitempty"`     // When the sample was collected
+	WindowSeconds      float64            `json:"windowSeconds,omitempty"` // Interval the usage was averaged over
 	Containers         []ContainerMetrics `json:"containers"`
 }
 
+// NamespaceMetrics represents the summed CPU and memory usage of all pods in a namespace
+type NamespaceMetrics struct {
+	Namespace          string  `json:"namespace"`
+	PodCount           int     `json:"podCount"`
+	CPUUsageMillicores int64   `json:"cpuUsageMillicores"`
+	CPUUsageCores      float64 `json:"cpuUsageCores"`
+	MemoryUsageMiB     int64   `json:"memoryUsageMiB"`
+	MemoryUsageBytes   int64   `json:"memoryUsageBytes"`
+}
+
+// MetricsSeries is the usage of a single node or pod across repeated samples, so trends like
+// steadily climbing memory are visible
+type MetricsSeries struct {
+	Name                string          `json:"name"`
+	Namespace           string          `json:"namespace,omitempty"`
+	Samples             []MetricsSample `json:"samples"`
+	CPUChangeMillicores int64           `json:"cpuChangeMillicores"` // Last sample minus first
+	MemoryChangeMiB     int64           `json:"memoryChangeMiB"`     // Last sample minus first
+}
+
+// MetricsSample is one point in a MetricsSeries
+type MetricsSample struct {
+	Timestamp          string `json:"timestamp,omitempty"` // When metrics-server collected the sample
+	CPUUsageMillicores int64  `json:"cpuUsageMillicores"`
+	MemoryUsageMiB     int64  `json:"memoryUsageMiB"`
+	MemoryUsageBytes   int64  `json:"memoryUsageBytes"`
+}
+
+// metricsPoint is a sample tagged with the node or pod it belongs to
+type metricsPoint struct {
+	Name      string
+	Namespace string
+	Sample    MetricsSample
+}
+
 // ContainerMetrics represents CPU and memory usage for a container
 type ContainerMetrics struct {
 	Name               string `json:"name"`
 	CPUUsageMillicores int64  `json:"cpuUsageMillicores"`
 	MemoryUsageMiB     int64  `json:"memoryUsageMiB"`
+	MemoryUsageBytes   int64  `json:"memoryUsageBytes"`
 }
 
 func RegisterGetK8sMetricsMCPTool(s *server.MCPServer) {
-	s.AddTool(newGetK8sMetricsMCPTool(), getK8sMetricsHandler)
+	addTool(s, newGetK8sMetricsMCPTool(), getK8sMetricsHandler)
 }
 
 // Tool schema
 func newGetK8sMetricsMCPTool() mcp.Tool {
 	return mcp.NewTool("get_k8s_metrics", readOnlyToolOptions(
-		mcp.WithDescription("Get Kubernetes resource metrics (CPU/memory usage) for nodes or pods, similar to kubectl top"),
+		mcp.WithDescription("Get Kubernetes resource metrics (CPU/memory usage) for nodes or pods, similar to kubectl top. CPU is reported in both millicores and cores."),
 		mcp.WithString(contextProperty,
 			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
 			mcp.Required(),
@@ -63,13 +135,44 @@ func newGetK8sMetricsMCPTool() mcp.Tool {
 			mcp.Required(),
 		),
 		mcp.WithString(namespaceProperty,
-			mcp.Description("The Kubernetes namespace to use. Ignored for nodes. If not provided for pods, shows metrics for all namespaces."),
+			mcp.Description("The Kubernetes namespace to use. Ignored for nodes. If not provided for pods, defaults to the context's configured namespace, or all namespaces if the context doesn't set one."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("Show pod metrics across all namespaces, ignoring the context's default namespace (like kubectl top pods -A). Cannot be used with namespace."),
 		),
 		mcp.WithString(nameProperty,
 			mcp.Description("Optional name to filter results by specific pod or node name."),
 		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Optional label selector to filter pods or nodes (e.g., 'app=web,tier!=cache'). Cannot be used with name."),
+		),
+		mcp.WithString(containerProperty,
+			mcp.Description("Optional container name to limit per-container metrics for pods. Pod totals still include all containers. Ignored for nodes."),
+		),
 		mcp.WithBoolean("sum",
-			mcp.Description("When listing multiple resources, include a TOTAL entry with the sum of all CPU and memory usage."),
+			mcp.Description("When listing multiple resources, include a TOTAL entry with the sum of all CPU and memory usage. When paginating, the total covers the current page only."),
+		),
+		mcp.WithNumber(limitProperty,
+			mcp.Description("Maximum number of pods to return per request when listing pod metrics. When limit or continue is set, results are returned as {items, metadata} with a continue token. Ignored for nodes."),
+		),
+		mcp.WithString(continueProperty,
+			mcp.Description("Continue token from a previous paginated pod metrics request. Ignored for nodes."),
+		),
+		mcp.WithBoolean(byNamespaceProperty,
+			mcp.Description("Aggregate pod metrics across all namespaces into per-namespace totals, sorted by usage, to find which namespaces use the most resources. "+
+				"Only supported for pods; cannot be used with namespace, name, container, limit, or continue."),
+		),
+		mcp.WithString(sortByProperty,
+			mcp.Description("Sort order for byNamespace results: 'cpu' (default) or 'memory', highest usage first."),
+			mcp.Enum(sortByCPU, sortByMemory),
+		),
+		mcp.WithNumber(samplesProperty,
+			mcp.Description(fmt.Sprintf("Take this many samples spaced by interval and return a time series per node or pod, with the change from first to last sample, "+
+				"to see whether usage is climbing. Defaults to 1 (a single snapshot), maximum %d. Series report pod totals; cannot be used with byNamespace, limit, or continue.", maxMetricsSamples)),
+		),
+		mcp.WithString(intervalProperty,
+			mcp.Description("Time between samples (e.g., '15s', '1m'). Defaults to 15s; the whole series may span at most 5m. "+
+				"metrics-server refreshes usage about every 15s, so shorter intervals repeat the same sample."),
 		),
 	)...)
 }
@@ -87,6 +190,25 @@ func getK8sMetricsHandler(ctx context.Context, request mcp.CallToolRequest) (*mc
 		return mcp.NewToolResultError("kind must be 'node' or 'pod'"), nil
 	}
 
+	// Preflight check so a missing metrics-server isn't reported as a generic failure.
+	// If discovery itself fails, fall through and let the metrics request surface the error.
+	if available, err := k8s.IsMetricsAPIAvailable(params.Context); err == nil && !available {
+		return mcp.NewToolResultError("metrics-server not available on this cluster (the metrics.k8s.io API is not registered); install it to use get_k8s_metrics"), nil
+	}
+
+	// Fall back to the context's default namespace for pods, like kubectl
+	if params.Kind == "pod" && params.Namespace == "" && !params.AllNamespaces && !params.ByNamespace {
+		params.Namespace, err = k8s.GetContextNamespace(params.Context)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read context namespace: %v", err)), nil
+		}
+	}
+	if params.Kind == "pod" {
+		if err := CheckNamespaceAccess(params.Namespace); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
 	// Get metrics client
 	metricsClient, err := k8s.GetMetricsClientForContext(params.Context)
 	if err != nil {
@@ -95,10 +217,23 @@ func getK8sMetricsHandler(ctx context.Context, request mcp.CallToolRequest) (*mc
 
 	// Get metrics based on kind
 	var content any
-	if params.Kind == "node" {
-		content, err = getNodeMetrics(ctx, metricsClient, params.Name, params.Sum)
-	} else {
-		content, err = getPodMetrics(ctx, metricsClient, params.Namespace, params.Name, params.Sum)
+	switch {
+	case params.Samples > 1:
+		content, err = sampleMetrics(ctx, metricsClient, params)
+	case params.Kind == "node":
+		content, err = getNodeMetrics(ctx, metricsClient, params)
+	case params.ByNamespace:
+		content, err = getNamespaceMetrics(ctx, metricsClient, params)
+	default:
+		var podMetrics []PodMetrics
+		var listMeta metav1.ListMeta
+		podMetrics, listMeta, err = getPodMetrics(ctx, metricsClient, params)
+		content = podMetrics
+
+		// Wrap paginated results with metadata like list_k8s_resources does
+		if err == nil && (params.Limit > 0 || params.Continue != "") {
+			content = paginatedPodMetrics(podMetrics, listMeta)
+		}
 	}
 
 	if err != nil {
@@ -123,16 +258,85 @@ func extractGetK8sMetricsParams(request mcp.CallToolRequest) (*getK8sMetricsPara
 	// Normalize kind to lowercase for consistency
 	kind = strings.ToLower(kind)
 
+	name := request.GetString(nameProperty, "")
+	labelSelector := request.GetString(labelSelectorProperty, "")
+	if name != "" && labelSelector != "" {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", nameProperty, labelSelectorProperty)
+	}
+
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	// Extract and validate limit (default to 0, meaning no limit)
+	limit, err := extractLimit(request, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	container := request.GetString(containerProperty, "")
+	continueToken := request.GetString(continueProperty, "")
+	byNamespace := request.GetBool(byNamespaceProperty, false)
+	if byNamespace {
+		if kind != "pod" {
+			return nil, fmt.Errorf("'%s' is only supported for pods", byNamespaceProperty)
+		}
+		if namespace != "" || name != "" || container != "" || limit > 0 || continueToken != "" {
+			return nil, fmt.Errorf("'%s' cannot be combined with '%s', '%s', '%s', '%s', or '%s'",
+				byNamespaceProperty, namespaceProperty, nameProperty, containerProperty, limitProperty, continueProperty)
+		}
+	}
+
+	sortBy := strings.ToLower(request.GetString(sortByProperty, sortByCPU))
+	if sortBy != sortByCPU && sortBy != sortByMemory {
+		return nil, fmt.Errorf("%s must be '%s' or '%s', got '%s'", sortByProperty, sortByCPU, sortByMemory, sortBy)
+	}
+
+	samples := request.GetInt(samplesProperty, 1)
+	if samples < 1 || samples > maxMetricsSamples {
+		return nil, fmt.Errorf("%s must be between 1 and %d, got %d", samplesProperty, maxMetricsSamples, samples)
+	}
+	if samples > 1 && (byNamespace || limit > 0 || continueToken != "") {
+		return nil, fmt.Errorf("'%s' cannot be combined with '%s', '%s', or '%s'", samplesProperty, byNamespaceProperty, limitProperty, continueProperty)
+	}
+
+	interval := defaultMetricsSampleInterval
+	if intervalStr := request.GetString(intervalProperty, ""); intervalStr != "" {
+		interval, err = time.ParseDuration(intervalStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval duration: %w", err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("interval must be positive, got %s", intervalStr)
+		}
+	}
+	if total := interval * time.Duration(samples-1); total > maxMetricsSamplingDuration {
+		return nil, fmt.Errorf("%d samples at a %s interval would take %s, more than the maximum of %s; reduce samples or interval",
+			samples, interval, total, maxMetricsSamplingDuration)
+	}
+
 	return &getK8sMetricsParams{
-		Context:   context,
-		Kind:      kind,
-		Namespace: request.GetString(namespaceProperty, metav1.NamespaceAll),
-		Name:      request.GetString(nameProperty, ""),
-		Sum:       request.GetBool("sum", false),
+		Context:       context,
+		Kind:          kind,
+		Namespace:     namespace,
+		AllNamespaces: allNamespaces,
+		Name:          name,
+		LabelSelector: labelSelector,
+		Container:     container,
+		Sum:           request.GetBool("sum", false),
+		Limit:         limit,
+		Continue:      continueToken,
+		ByNamespace:   byNamespace,
+		SortBy:        sortBy,
+		Samples:       samples,
+		Interval:      interval,
 	}, nil
 }
 
-func getNodeMetrics(ctx context.Context, metricsClient metrics.Interface, nodeName string, includeSum bool) ([]NodeMetrics, error) {
+func getNodeMetrics(ctx context.Context, metricsClient metrics.Interface, params *getK8sMetricsParams) ([]NodeMetrics, error) {
+	nodeName := params.Name
 	if nodeName != "" {
 		// Get specific node - sum not applicable for single item
 		nodeMetric, err := metricsClient.MetricsV1beta1().NodeMetricses().Get(ctx, nodeName, metav1.GetOptions{})
@@ -145,13 +349,13 @@ func getNodeMetrics(ctx context.Context, metricsClient metrics.Interface, nodeNa
 	}
 
 	// Get all nodes
-	nodeMetricsList, err := metricsClient.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	nodeMetricsList, err := metricsClient.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{LabelSelector: params.LabelSelector})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list node metrics: %w", err)
 	}
 
 	var nodeMetrics []NodeMetrics
-	var totalCPUMillicores, totalMemoryMiB int64
+	var totalCPUMillicores, totalMemoryBytes int64
 
 	for _, nodeMetric := range nodeMetricsList.Items {
 		processed := processNodeMetric(&nodeMetric)
@@ -159,53 +363,61 @@ func getNodeMetrics(ctx context.Context, metricsClient metrics.Interface, nodeNa
 
 		// Add to totals
 		totalCPUMillicores += processed.CPUUsageMillicores
-		totalMemoryMiB += processed.MemoryUsageMiB
+		totalMemoryBytes += processed.MemoryUsageBytes
 	}
 
 	// Add total entry if requested
-	if includeSum {
+	if params.Sum {
 		nodeMetrics = append(nodeMetrics, NodeMetrics{
 			Name:               "TOTAL",
 			CPUUsageMillicores: totalCPUMillicores,
-			MemoryUsageMiB:     totalMemoryMiB,
+			CPUUsageCores:      millicoresToCores(totalCPUMillicores),
+			MemoryUsageMiB:     bytesToMiB(totalMemoryBytes),
+			MemoryUsageBytes:   totalMemoryBytes,
 		})
 	}
 
 	return nodeMetrics, nil
 }
 
-func getPodMetrics(ctx context.Context, metricsClient metrics.Interface, namespace string, podName string, includeSum bool) ([]PodMetrics, error) {
+func getPodMetrics(ctx context.Context, metricsClient metrics.Interface, params *getK8sMetricsParams) ([]PodMetrics, metav1.ListMeta, error) {
+	namespace, podName := params.Namespace, params.Name
 	if podName != "" {
 		// Get specific pod - sum not applicable for single item
 		podMetric, err := metricsClient.MetricsV1beta1().PodMetricses(namespace).Get(ctx, podName, metav1.GetOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to get pod metrics for %s: %w", podName, err)
+			return nil, metav1.ListMeta{}, fmt.Errorf("failed to get pod metrics for %s: %w", podName, err)
 		}
 
-		processed := processPodMetric(podMetric)
-		return []PodMetrics{processed}, nil
+		processed := processPodMetric(podMetric, params.Container)
+		return []PodMetrics{processed}, metav1.ListMeta{}, nil
 	}
 
 	// Get metrics for all pods in the namespace(s)
-	podMetricsList, err := metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
+	listOptions := metav1.ListOptions{
+		LabelSelector: params.LabelSelector,
+		Limit:         params.Limit,
+		Continue:      params.Continue,
+	}
+	podMetricsList, err := metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, listOptions)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list pod metrics: %w", err)
+		return nil, metav1.ListMeta{}, fmt.Errorf("failed to list pod metrics: %w", err)
 	}
 
 	podMetrics := make([]PodMetrics, 0, len(podMetricsList.Items))
-	var totalCPUMillicores, totalMemoryMiB int64
+	var totalCPUMillicores, totalMemoryBytes int64
 
 	for _, podMetric := range podMetricsList.Items {
-		processed := processPodMetric(&podMetric)
+		processed := processPodMetric(&podMetric, params.Container)
 		podMetrics = append(podMetrics, processed)
 
 		// Add to totals
 		totalCPUMillicores += processed.CPUUsageMillicores
-		totalMemoryMiB += processed.MemoryUsageMiB
+		totalMemoryBytes += processed.MemoryUsageBytes
 	}
 
 	// Add total entry if requested
-	if includeSum {
+	if params.Sum {
 		// Determine namespace for total - use "ALL" for cross-namespace queries
 		totalNamespace := namespace
 		if namespace == metav1.NamespaceAll {
@@ -216,52 +428,279 @@ func getPodMetrics(ctx context.Context, metricsClient metrics.Interface, namespa
 			Name:               "TOTAL",
 			Namespace:          totalNamespace,
 			CPUUsageMillicores: totalCPUMillicores,
-			MemoryUsageMiB:     totalMemoryMiB,
+			CPUUsageCores:      millicoresToCores(totalCPUMillicores),
+			MemoryUsageMiB:     bytesToMiB(totalMemoryBytes),
+			MemoryUsageBytes:   totalMemoryBytes,
 			Containers:         []ContainerMetrics{}, // Empty containers for total
 		})
 	}
 
-	return podMetrics, nil
+	return podMetrics, podMetricsList.ListMeta, nil
+}
+
+// getNamespaceMetrics sums pod metrics across all namespaces so only the per-namespace
+// totals are returned instead of every pod's metrics
+func getNamespaceMetrics(ctx context.Context, metricsClient metrics.Interface, params *getK8sMetricsParams) ([]NamespaceMetrics, error) {
+	podMetricsList, err := metricsClient.MetricsV1beta1().PodMetricses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: params.LabelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod metrics: %w", err)
+	}
+
+	namespaceMetrics := aggregateNamespaceMetrics(podMetricsList.Items, params.SortBy)
+
+	// Add total entry if requested
+	if params.Sum {
+		total := NamespaceMetrics{Namespace: "TOTAL"}
+		for _, ns := range namespaceMetrics {
+			total.PodCount += ns.PodCount
+			total.CPUUsageMillicores += ns.CPUUsageMillicores
+			total.MemoryUsageBytes += ns.MemoryUsageBytes
+		}
+		total.CPUUsageCores = millicoresToCores(total.CPUUsageMillicores)
+		total.MemoryUsageMiB = bytesToMiB(total.MemoryUsageBytes)
+		namespaceMetrics = append(namespaceMetrics, total)
+	}
+
+	return namespaceMetrics, nil
+}
+
+// aggregateNamespaceMetrics sums pod usage per namespace, sorted by the given resource with
+// the highest usage first and ties broken by namespace name
+func aggregateNamespaceMetrics(podMetrics []metricsv1beta1.PodMetrics, sortBy string) []NamespaceMetrics {
+	byNamespace := make(map[string]*NamespaceMetrics)
+	for i := range podMetrics {
+		processed := processPodMetric(&podMetrics[i], "")
+
+		ns, ok := byNamespace[processed.Namespace]
+		if !ok {
+			ns = &NamespaceMetrics{Namespace: processed.Namespace}
+			byNamespace[processed.Namespace] = ns
+		}
+		ns.PodCount++
+		ns.CPUUsageMillicores += processed.CPUUsageMillicores
+		ns.MemoryUsageBytes += processed.MemoryUsageBytes
+	}
+
+	namespaceMetrics := make([]NamespaceMetrics, 0, len(byNamespace))
+	for _, ns := range byNamespace {
+		ns.CPUUsageCores = millicoresToCores(ns.CPUUsageMillicores)
+		ns.MemoryUsageMiB = bytesToMiB(ns.MemoryUsageBytes)
+		namespaceMetrics = append(namespaceMetrics, *ns)
+	}
+
+	sort.Slice(namespaceMetrics, func(i, j int) bool {
+		a, b := namespaceMetrics[i], namespaceMetrics[j]
+		usageA, usageB := a.CPUUsageMillicores, b.CPUUsageMillicores
+		if sortBy == sortByMemory {
+			usageA, usageB = a.MemoryUsageBytes, b.MemoryUsageBytes
+		}
+		if usageA != usageB {
+			return usageA > usageB
+		}
+		return a.Namespace < b.Namespace
+	})
+
+	return namespaceMetrics
+}
+
+// sampleMetrics takes repeated node or pod metrics snapshots and returns them as a series per
+// node or pod. If the request is cancelled or a later sample fails, the samples collected so far
+// are returned with a warning.
+func sampleMetrics(ctx context.Context, metricsClient metrics.Interface, params *getK8sMetricsParams) (map[string]any, error) {
+	fetch := func(ctx context.Context) ([]metricsPoint, error) {
+		var points []metricsPoint
+		if params.Kind == "node" {
+			nodeMetrics, err := getNodeMetrics(ctx, metricsClient, params)
+			if err != nil {
+				return nil, err
+			}
+			for _, node := range nodeMetrics {
+				points = append(points, metricsPoint{
+					Name:   node.Name,
+					Sample: metricsSample(node.Timestamp, node.CPUUsageMillicores, node.MemoryUsageBytes),
+				})
+			}
+			return points, nil
+		}
+
+		podMetrics, _, err := getPodMetrics(ctx, metricsClient, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range podMetrics {
+			points = append(points, metricsPoint{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+				Sample:    metricsSample(pod.Timestamp, pod.CPUUsageMillicores, pod.MemoryUsageBytes),
+			})
+		}
+		return points, nil
+	}
+
+	series, taken, warning, err := collectMetricsSeries(ctx, params.Samples, params.Interval, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	response := map[string]any{
+		"samples":  taken,
+		"interval": params.Interval.String(),
+		"series":   series,
+	}
+	if warning != "" {
+		response["warning"] = warning
+	}
+	return response, nil
 }
 
-// Helper function to convert resource usage to standard units
-func convertResourceUsage(usage corev1.ResourceList) (cpuMillicores int64, memoryMiB int64) {
+// collectMetricsSeries calls fetch up to samples times, waiting interval between calls, and groups
+// the points into series in order of first appearance. It returns the number of samples taken and
+// a warning when sampling stopped early; only a failure of the first sample is returned as an error.
+func collectMetricsSeries(ctx context.Context, samples int, interval time.Duration, fetch func(context.Context) ([]metricsPoint, error)) ([]MetricsSeries, int, string, error) {
+	series := []MetricsSeries{}
+	index := map[string]int{}
+	taken := 0
+	warning := ""
+
+sampling:
+	for taken < samples {
+		if taken > 0 {
+			select {
+			case <-ctx.Done():
+				warning = fmt.Sprintf("sampling stopped after %d of %d samples: %v", taken, samples, ctx.Err())
+				break sampling
+			case <-time.After(interval):
+			}
+		}
+
+		points, err := fetch(ctx)
+		if err != nil {
+			if taken == 0 {
+				return nil, 0, "", err
+			}
+			warning = fmt.Sprintf("sampling stopped after %d of %d samples: %v", taken, samples, err)
+			break sampling
+		}
+		taken++
+
+		for _, point := range points {
+			key := point.Namespace + "/" + point.Name
+			i, ok := index[key]
+			if !ok {
+				i = len(series)
+				index[key] = i
+				series = append(series, MetricsSeries{Name: point.Name, Namespace: point.Namespace})
+			}
+			series[i].Samples = append(series[i].Samples, point.Sample)
+		}
+	}
+
+	for i := range series {
+		first, last := series[i].Samples[0], series[i].Samples[len(series[i].Samples)-1]
+		series[i].CPUChangeMillicores = last.CPUUsageMillicores - first.CPUUsageMillicores
+		series[i].MemoryChangeMiB = last.MemoryUsageMiB - first.MemoryUsageMiB
+	}
+
+	return series, taken, warning, nil
+}
+
+// metricsSample builds a series sample, converting memory to MiB like the snapshot results
+func metricsSample(timestamp string, cpuMillicores, memoryBytes int64) MetricsSample {
+	return MetricsSample{
+		Timestamp:          timestamp,
+		CPUUsageMillicores: cpuMillicores,
+		MemoryUsageMiB:     bytesToMiB(memoryBytes),
+		MemoryUsageBytes:   memoryBytes,
+	}
+}
+
+// paginatedPodMetrics wraps a page of pod metrics with its continue token and remaining count
+func paginatedPodMetrics(podMetrics []PodMetrics, listMeta metav1.ListMeta) map[string]any {
+	response := map[string]any{
+		"items": podMetrics,
+	}
+
+	metadata := map[string]any{}
+	if listMeta.Continue != "" {
+		metadata["continue"] = listMeta.Continue
+	}
+	if listMeta.RemainingItemCount != nil {
+		metadata["remainingItemCount"] = *listMeta.RemainingItemCount
+	}
+	if len(metadata) > 0 {
+		response["metadata"] = metadata
+	}
+
+	return response
+}
+
+// Helper function to convert resource usage to standard units.
+// Memory is returned in bytes so sub-MiB usage isn't lost when summing.
+func convertResourceUsage(usage corev1.ResourceList) (cpuMillicores int64, memoryBytes int64) {
 	cpuQuantity := usage["cpu"]
 	memoryQuantity := usage["memory"]
 
 	cpuMillicores = cpuQuantity.MilliValue()
-	memoryMiB = memoryQuantity.Value() / (1024 * 1024) // Convert bytes to MiB
+	memoryBytes = memoryQuantity.Value()
+
+	return cpuMillicores, memoryBytes
+}
+
+// Helper function to convert millicores to cores, e.g. 12500 -> 12.5
+func millicoresToCores(millicores int64) float64 {
+	return float64(millicores) / 1000
+}
+
+// Helper function to format a sample timestamp as RFC3339, leaving it empty when unset
+func formatMetricsTimestamp(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return ""
+	}
+	return timestamp.UTC().Format(time.RFC3339)
+}
 
-	return cpuMillicores, memoryMiB
+// Helper function to convert bytes to MiB
+func bytesToMiB(bytes int64) int64 {
+	return bytes / (1024 * 1024)
 }
 
 // Helper function to process a single node metric
 func processNodeMetric(nodeMetric *metricsv1beta1.NodeMetrics) NodeMetrics {
-	cpuUsageMillicores, memoryUsageMiB := convertResourceUsage(nodeMetric.Usage)
+	cpuUsageMillicores, memoryUsageBytes := convertResourceUsage(nodeMetric.Usage)
 
 	return NodeMetrics{
 		Name:               nodeMetric.Name,
 		CPUUsageMillicores: cpuUsageMillicores,
-		MemoryUsageMiB:     memoryUsageMiB,
+		CPUUsageCores:      millicoresToCores(cpuUsageMillicores),
+		MemoryUsageMiB:     bytesToMiB(memoryUsageBytes),
+		MemoryUsageBytes:   memoryUsageBytes,
+		Timestamp:          formatMetricsTimestamp(nodeMetric.Timestamp),
+		WindowSeconds:      nodeMetric.Window.Seconds(),
 	}
 }
 
-// Helper function to process a single pod metric
-func processPodMetric(podMetric *metricsv1beta1.PodMetrics) PodMetrics {
+// Helper function to process a single pod metric.
+// If containerName is set, only that container is listed but pod totals include all containers.
+func processPodMetric(podMetric *metricsv1beta1.PodMetrics, containerName string) PodMetrics {
 	// Calculate total pod CPU and memory usage from all containers
-	var totalCPUMillicores, totalMemoryMiB int64
+	var totalCPUMillicores, totalMemoryBytes int64
 	containers := make([]ContainerMetrics, 0, len(podMetric.Containers))
 
 	for _, container := range podMetric.Containers {
-		cpuUsageMillicores, memoryUsageMiB := convertResourceUsage(container.Usage)
+		cpuUsageMillicores, memoryUsageBytes := convertResourceUsage(container.Usage)
 
 		totalCPUMillicores += cpuUsageMillicores
-		totalMemoryMiB += memoryUsageMiB
+		totalMemoryBytes += memoryUsageBytes
+
+		if containerName != "" && container.Name != containerName {
+			continue
+		}
 
 		containers = append(containers, ContainerMetrics{
 			Name:               container.Name,
 			CPUUsageMillicores: cpuUsageMillicores,
-			MemoryUsageMiB:     memoryUsageMiB,
+			MemoryUsageMiB:     bytesToMiB(memoryUsageBytes),
+			MemoryUsageBytes:   memoryUsageBytes,
 		})
 	}
 
@@ -269,7 +708,11 @@ func processPodMetric(podMetric *metricsv1beta1.PodMetrics) PodMetrics {
 		Name:               podMetric.Name,
 		Namespace:          podMetric.Namespace,
 		CPUUsageMillicores: totalCPUMillicores,
-		MemoryUsageMiB:     totalMemoryMiB,
+		CPUUsageCores:      millicoresToCores(totalCPUMillicores),
+		MemoryUsageMiB:     bytesToMiB(totalMemoryBytes),
+		MemoryUsageBytes:   totalMemoryBytes,
+		Timestamp:          formatMetricsTimestamp(podMetric.Timestamp),
+		WindowSeconds:      podMetric.Window.Seconds(),
 		Containers:         containers,
 	}
 }
@@ -0,0 +1,176 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestPaginatedPodMetrics(t *testing.T) {
+	podMetrics := []PodMetrics{{Name: "web-1", Namespace: "default"}}
+
+	t.Run("with continue token", func(t *testing.T) {
+		response := paginatedPodMetrics(podMetrics, metav1.ListMeta{Continue: "abc", RemainingItemCount: ptrTo[int64](5)})
+
+		metadata, ok := response["metadata"].(map[string]any)
+		if !ok {
+			t.Fatal("expected metadata in response")
+		}
+		if metadata["continue"] != "abc" {
+			t.Errorf("expected continue token 'abc', got %v", metadata["continue"])
+		}
+		if metadata["remainingItemCount"] != int64(5) {
+			t.Errorf("expected remainingItemCount 5, got %v", metadata["remainingItemCount"])
+		}
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		response := paginatedPodMetrics(podMetrics, metav1.ListMeta{})
+
+		if _, ok := response["metadata"]; ok {
+			t.Error("expected no metadata on the last page")
+		}
+		if items, ok := response["items"].([]PodMetrics); !ok || len(items) != 1 {
+			t.Errorf("expected 1 item, got %v", response["items"])
+		}
+	})
+}
+
+func TestProcessNodeMetric(t *testing.T) {
+	nodeMetric := &metricsv1beta1.NodeMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Timestamp:  metav1.NewTime(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)),
+		Window:     metav1.Duration{Duration: 15 * time.Second},
+		Usage: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("12500m"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}
+
+	got := processNodeMetric(nodeMetric)
+	if got.CPUUsageMillicores != 12500 {
+		t.Errorf("expected 12500 millicores, got %d", got.CPUUsageMillicores)
+	}
+	if got.CPUUsageCores != 12.5 {
+		t.Errorf("expected 12.5 cores, got %v", got.CPUUsageCores)
+	}
+	if got.Timestamp != "2025-06-01T12:00:00Z" {
+		t.Errorf("expected timestamp 2025-06-01T12:00:00Z, got %q", got.Timestamp)
+	}
+	if got.WindowSeconds != 15 {
+		t.Errorf("expected 15 second window, got %v", got.WindowSeconds)
+	}
+}
+
+func TestAggregateNamespaceMetrics(t *testing.T) {
+	newPodMetric := func(namespace, name, cpu, memory string) metricsv1beta1.PodMetrics {
+		return metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name: "app",
+				Usage: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+			}},
+		}
+	}
+	podMetrics := []metricsv1beta1.PodMetrics{
+		newPodMetric("backend", "api-1", "500m", "256Mi"),
+		newPodMetric("backend", "api-2", "700m", "256Mi"),
+		newPodMetric("monitoring", "prometheus-0", "800m", "4Gi"),
+		newPodMetric("default", "debug", "10m", "16Mi"),
+	}
+
+	t.Run("by cpu", func(t *testing.T) {
+		got := aggregateNamespaceMetrics(podMetrics, sortByCPU)
+
+		want := []string{"backend", "monitoring", "default"}
+		if len(got) != len(want) {
+			t.Fatalf("expected %d namespaces, got %d", len(want), len(got))
+		}
+		for i, ns := range want {
+			if got[i].Namespace != ns {
+				t.Errorf("expected namespace %d to be %q, got %q", i, ns, got[i].Namespace)
+			}
+		}
+		if got[0].PodCount != 2 || got[0].CPUUsageMillicores != 1200 || got[0].CPUUsageCores != 1.2 || got[0].MemoryUsageMiB != 512 {
+			t.Errorf("unexpected backend totals: %+v", got[0])
+		}
+	})
+
+	t.Run("by memory", func(t *testing.T) {
+		got := aggregateNamespaceMetrics(podMetrics, sortByMemory)
+		if got[0].Namespace != "monitoring" {
+			t.Errorf("expected monitoring first, got %q", got[0].Namespace)
+		}
+	})
+}
+
+func TestCollectMetricsSeries(t *testing.T) {
+	point := func(name string, cpu, memoryMiB int64) metricsPoint {
+		return metricsPoint{Name: name, Namespace: "default", Sample: metricsSample("", cpu, memoryMiB*1024*1024)}
+	}
+
+	t.Run("groups samples per pod", func(t *testing.T) {
+		calls := 0
+		fetch := func(context.Context) ([]metricsPoint, error) {
+			calls++
+			points := []metricsPoint{point("web", 100, int64(100*calls))}
+			if calls > 1 {
+				// A pod appearing after the first sample gets a shorter series
+				points = append(points, point("new", 50, 10))
+			}
+			return points, nil
+		}
+
+		series, taken, warning, err := collectMetricsSeries(context.Background(), 3, time.Millisecond, fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if taken != 3 || warning != "" {
+			t.Errorf("expected 3 samples without warning, got %d (%q)", taken, warning)
+		}
+		if len(series) != 2 || series[0].Name != "web" || series[1].Name != "new" {
+			t.Fatalf("unexpected series: %+v", series)
+		}
+		if len(series[0].Samples) != 3 || series[0].MemoryChangeMiB != 200 || series[0].CPUChangeMillicores != 0 {
+			t.Errorf("unexpected web series: %+v", series[0])
+		}
+		if len(series[1].Samples) != 2 || series[1].MemoryChangeMiB != 0 {
+			t.Errorf("unexpected new series: %+v", series[1])
+		}
+	})
+
+	t.Run("first sample failure is an error", func(t *testing.T) {
+		fetch := func(context.Context) ([]metricsPoint, error) {
+			return nil, errors.New("boom")
+		}
+		if _, _, _, err := collectMetricsSeries(context.Background(), 3, time.Millisecond, fetch); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("cancellation returns partial series", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		fetch := func(context.Context) ([]metricsPoint, error) {
+			cancel()
+			return []metricsPoint{point("web", 100, 100)}, nil
+		}
+
+		series, taken, warning, err := collectMetricsSeries(ctx, 5, time.Hour, fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if taken != 1 || len(series) != 1 || !strings.Contains(warning, "1 of 5") {
+			t.Errorf("expected 1 sample with a warning, got %d samples, %d series, warning %q", taken, len(series), warning)
+		}
+	})
+}
@@ -0,0 +1,373 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// Edge types in a namespace graph
+const (
+	graphEdgeOwns    = "owns"    // ownerReferences, e.g. Deployment -> ReplicaSet -> Pod
+	graphEdgeSelects = "selects" // Service spec.selector matching Pod labels
+	graphEdgeRoutes  = "routes"  // Ingress backend referencing a Service
+)
+
+// maxGraphNodes caps the nodes in a namespace graph, and the page size of each list, so a large
+// namespace can't produce an unbounded response
+const maxGraphNodes = 1000
+
+// graphKind is a resource type included in a namespace graph. Only kinds whose spec or status
+// the graph reads are listed as full objects; the rest only need metadata for owner edges.
+type graphKind struct {
+	kind string
+	gvr  schema.GroupVersionResource
+	full bool
+}
+
+// graphKinds are the kinds included in a namespace graph, in node order
+var graphKinds = []graphKind{
+	{kind: "Ingress", gvr: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}, full: true},
+	{kind: "Service", gvr: schema.GroupVersionResource{Version: "v1", Resource: "services"}, full: true},
+	{kind: rolloutKindDeployment, gvr: workloadGVRs[rolloutKindDeployment]},
+	{kind: rolloutKindStatefulSet, gvr: workloadGVRs[rolloutKindStatefulSet]},
+	{kind: rolloutKindDaemonSet, gvr: workloadGVRs[rolloutKindDaemonSet]},
+	{kind: "CronJob", gvr: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}},
+	{kind: "Job", gvr: jobGVR},
+	{kind: "ReplicaSet", gvr: replicaSetGVR},
+	{kind: "Pod", gvr: podGVR, full: true},
+}
+
+type getK8sNamespaceGraphParams struct {
+	Context   string
+	Namespace string
+}
+
+// NamespaceGraph is the ownership and selector topology of a namespace as nodes and edges
+type NamespaceGraph struct {
+	Namespace string                 `json:"namespace"`
+	Nodes     []GraphNode            `json:"nodes"`
+	Edges     []GraphEdge            `json:"edges"`
+	Skipped   []SkippedInventoryKind `json:"skipped,omitempty"`   // Kinds that couldn't be listed, e.g. due to RBAC
+	Truncated bool                   `json:"truncated,omitempty"` // A list or the node count hit maxGraphNodes
+}
+
+// GraphNode is a resource in a namespace graph, identified as "Kind/name"
+type GraphNode struct {
+	ID      string `json:"id"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Status  string `json:"status,omitempty"`  // Pod phase
+	Missing bool   `json:"missing,omitempty"` // Referenced by an Ingress but not found in the namespace
+}
+
+// GraphEdge is a relationship between two nodes
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+func RegisterGetK8sNamespaceGraphMCPTool(s *server.MCPServer) {
+	addTool(s, newGetK8sNamespaceGraphMCPTool(), getK8sNamespaceGraphHandler)
+}
+
+// Tool schema
+func newGetK8sNamespaceGraphMCPTool() mcp.Tool {
+	return mcp.NewTool("get_k8s_namespace_graph", readOnlyToolOptions(
+		mcp.WithDescription("Build the resource topology of a namespace as nodes and edges: ownership (Deployment -> ReplicaSet -> Pod, CronJob -> Job -> Pod, StatefulSet/DaemonSet -> Pod), "+
+			"Service -> Pod via selectors, and Ingress -> Service via backends. Node ids are 'Kind/name'. "+
+			"ReplicaSets without pods (old rollout revisions) are omitted, and Services referenced by an Ingress but not found are marked missing. "+
+			fmt.Sprintf("Graphs are capped at %d nodes; truncated is true when the namespace has more.", maxGraphNodes)),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to graph."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func getK8sNamespaceGraphHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractGetK8sNamespaceGraphParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	// Get metadata client
+	metadataClient, err := k8s.GetMetadataClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create metadata client: %v", err)), nil
+	}
+
+	resources, truncated, skipped, err := listGraphResources(ctx, dynamicClient, metadataClient, params.Namespace)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build namespace graph: %v", err)), nil
+	}
+
+	graph := buildNamespaceGraph(params.Namespace, resources, maxGraphNodes)
+	graph.Skipped = skipped
+	graph.Truncated = graph.Truncated || truncated
+
+	// Return as JSON
+	return toJSONToolResult(graph)
+}
+
+// listGraphResources lists each graph kind in the namespace concurrently, keyed by kind. Kinds
+// that only contribute owner edges are listed as metadata, and each list is bounded by
+// maxGraphNodes; the returned bool reports whether any list was cut short. Kinds that fail to
+// list are returned as skipped rather than failing the graph; only cancellation of the request
+// itself is returned as an error.
+func listGraphResources(ctx context.Context, dynamicClient dynamic.Interface, metadataClient metadata.Interface, namespace string) (map[string][]unstructured.Unstructured, bool, []SkippedInventoryKind, error) {
+	resources := make(map[string][]unstructured.Unstructured, len(graphKinds))
+	truncated := false
+	var skipped []SkippedInventoryKind
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentKinds)
+	for _, graphKind := range graphKinds {
+		g.Go(func() error {
+			items, more, err := listGraphKind(gctx, dynamicClient, metadataClient, graphKind, namespace)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				skipped = append(skipped, SkippedInventoryKind{
+					Kind:       graphKind.kind,
+					APIVersion: graphKind.gvr.GroupVersion().String(),
+					Reason:     categorizeK8sError("list", graphKind.gvr, namespace, "", err).Error(),
+				})
+				return nil
+			}
+			resources[graphKind.kind] = items
+			truncated = truncated || more
+			return nil
+		})
+	}
+	_ = g.Wait() // Per-kind errors are collected in skipped
+	if err := ctx.Err(); err != nil {
+		return nil, false, nil, err
+	}
+
+	sort.Slice(skipped, func(i, j int) bool { return skipped[i].Kind < skipped[j].Kind })
+	return resources, truncated, skipped, nil
+}
+
+// listGraphKind lists one page of up to maxGraphNodes resources of a graph kind, as full objects
+// or as metadata-only objects, reporting whether the namespace has more
+func listGraphKind(ctx context.Context, dynamicClient dynamic.Interface, metadataClient metadata.Interface, graphKind graphKind, namespace string) ([]unstructured.Unstructured, bool, error) {
+	listOptions := metav1.ListOptions{Limit: maxGraphNodes}
+	if graphKind.full {
+		list, err := dynamicClient.Resource(graphKind.gvr).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, false, err
+		}
+		return list.Items, list.GetContinue() != "", nil
+	}
+
+	list, err := metadataClient.Resource(graphKind.gvr).Namespace(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, false, err
+	}
+	items := make([]unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&list.Items[i])
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, unstructured.Unstructured{Object: object})
+	}
+	return items, list.GetContinue() != "", nil
+}
+
+// buildNamespaceGraph links the listed resources by ownerReferences, Service selectors, and
+// Ingress backends. A kind missing from resources is treated as unlisted, so an Ingress backend
+// is only marked missing when Services were listed. Nodes beyond maxNodes are dropped, along
+// with their edges, and the graph is marked truncated.
+func buildNamespaceGraph(namespace string, resources map[string][]unstructured.Unstructured, maxNodes int) *NamespaceGraph {
+	graph := &NamespaceGraph{
+		Namespace: namespace,
+		Nodes:     []GraphNode{},
+		Edges:     []GraphEdge{},
+	}
+	nodes := map[string]bool{}
+	addNode := func(node GraphNode) bool {
+		if nodes[node.ID] {
+			return true
+		}
+		if len(graph.Nodes) >= maxNodes {
+			graph.Truncated = true
+			return false
+		}
+		nodes[node.ID] = true
+		graph.Nodes = append(graph.Nodes, node)
+		return true
+	}
+	edges := map[GraphEdge]bool{}
+	addEdge := func(edge GraphEdge) {
+		if !edges[edge] && nodes[edge.From] && nodes[edge.To] {
+			edges[edge] = true
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	// ReplicaSets are listed as metadata, so old rollout revisions are recognized by owning no
+	// pods. Without a pod list every ReplicaSet is kept.
+	_, podsListed := resources["Pod"]
+	podOwners := map[types.UID]bool{}
+	for _, pod := range resources["Pod"] {
+		for _, ref := range pod.GetOwnerReferences() {
+			podOwners[ref.UID] = true
+		}
+	}
+
+	// Add a node per resource, indexing UIDs so owner references resolve to graph nodes
+	uids := map[types.UID]string{}
+	var included []unstructured.Unstructured
+	for _, graphKind := range graphKinds {
+		for _, item := range resources[graphKind.kind] {
+			if graphKind.kind == "ReplicaSet" && podsListed && !podOwners[item.GetUID()] {
+				continue
+			}
+			node := GraphNode{ID: graphNodeID(graphKind.kind, item.GetName()), Kind: graphKind.kind, Name: item.GetName()}
+			if graphKind.kind == "Pod" {
+				node.Status, _, _ = unstructured.NestedString(item.Object, "status", "phase")
+			}
+			if !addNode(node) {
+				continue
+			}
+			uids[item.GetUID()] = node.ID
+			included = append(included, item)
+		}
+	}
+
+	// Ownership edges; owners of other kinds (e.g. operator-managed custom resources) are added as
+	// bare nodes so the chain isn't cut off
+	for _, item := range included {
+		itemID := uids[item.GetUID()]
+		for _, ref := range item.GetOwnerReferences() {
+			ownerID, found := uids[ref.UID]
+			if !found {
+				ownerID = graphNodeID(ref.Kind, ref.Name)
+				addNode(GraphNode{ID: ownerID, Kind: ref.Kind, Name: ref.Name})
+			}
+			addEdge(GraphEdge{From: ownerID, To: itemID, Type: graphEdgeOwns})
+		}
+	}
+
+	// Service -> Pod edges; Services without a selector don't select pods directly
+	for _, service := range resources["Service"] {
+		selector, found, _ := unstructured.NestedStringMap(service.Object, "spec", "selector")
+		if !found || len(selector) == 0 {
+			continue
+		}
+		labelSelector := labels.SelectorFromSet(selector)
+		for _, pod := range resources["Pod"] {
+			if labelSelector.Matches(labels.Set(pod.GetLabels())) {
+				addEdge(GraphEdge{From: graphNodeID("Service", service.GetName()), To: graphNodeID("Pod", pod.GetName()), Type: graphEdgeSelects})
+			}
+		}
+	}
+
+	// Ingress -> Service edges
+	_, servicesListed := resources["Service"]
+	for _, ingress := range resources["Ingress"] {
+		for _, serviceName := range ingressBackendServices(ingress) {
+			serviceID := graphNodeID("Service", serviceName)
+			if !nodes[serviceID] {
+				addNode(GraphNode{ID: serviceID, Kind: "Service", Name: serviceName, Missing: servicesListed && !graph.Truncated})
+			}
+			addEdge(GraphEdge{From: graphNodeID("Ingress", ingress.GetName()), To: serviceID, Type: graphEdgeRoutes})
+		}
+	}
+
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+	return graph
+}
+
+// ingressBackendServices returns the Service names an Ingress routes to, from its default
+// backend and rule paths, in order of first reference
+func ingressBackendServices(ingress unstructured.Unstructured) []string {
+	var names []string
+	seen := map[string]bool{}
+	addBackend := func(backend map[string]any) {
+		if name, found, _ := unstructured.NestedString(backend, "service", "name"); found && name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	if backend, found, _ := unstructured.NestedMap(ingress.Object, "spec", "defaultBackend"); found {
+		addBackend(backend)
+	}
+	rules, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "rules")
+	for _, rule := range rules {
+		ruleMap, ok := rule.(map[string]any)
+		if !ok {
+			continue
+		}
+		paths, _, _ := unstructured.NestedSlice(ruleMap, "http", "paths")
+		for _, path := range paths {
+			if pathMap, ok := path.(map[string]any); ok {
+				if backend, found, _ := unstructured.NestedMap(pathMap, "backend"); found {
+					addBackend(backend)
+				}
+			}
+		}
+	}
+	return names
+}
+
+func graphNodeID(kind, name string) string {
+	return kind + "/" + name
+}
+
+func extractGetK8sNamespaceGraphParams(request mcp.CallToolRequest) (*getK8sNamespaceGraphParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("%s must not be empty", namespaceProperty)
+	}
+
+	return &getK8sNamespaceGraphParams{
+		Context:   context,
+		Namespace: namespace,
+	}, nil
+}
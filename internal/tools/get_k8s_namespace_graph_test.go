@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+func TestBuildNamespaceGraph(t *testing.T) {
+	newItem := func(kind, name, uid string, labels map[string]string, owner *metav1.OwnerReference, fields map[string]any) unstructured.Unstructured {
+		item := unstructured.Unstructured{Object: fields}
+		if item.Object == nil {
+			item.Object = map[string]any{}
+		}
+		item.SetKind(kind)
+		item.SetName(name)
+		item.SetNamespace("shop")
+		item.SetUID(types.UID(uid))
+		item.SetLabels(labels)
+		if owner != nil {
+			item.SetOwnerReferences([]metav1.OwnerReference{*owner})
+		}
+		return item
+	}
+	ownedBy := func(kind, name, uid string) *metav1.OwnerReference {
+		return &metav1.OwnerReference{Kind: kind, Name: name, UID: types.UID(uid)}
+	}
+	webLabels := map[string]string{"app": "web"}
+
+	resources := map[string][]unstructured.Unstructured{
+		"Ingress": {newItem("Ingress", "web", "ing-1", nil, nil, map[string]any{
+			"spec": map[string]any{
+				"defaultBackend": map[string]any{"service": map[string]any{"name": "web"}},
+				"rules": []any{map[string]any{"http": map[string]any{"paths": []any{
+					map[string]any{"backend": map[string]any{"service": map[string]any{"name": "web"}}},
+					map[string]any{"backend": map[string]any{"service": map[string]any{"name": "api"}}},
+				}}}},
+			},
+		})},
+		"Service": {newItem("Service", "web", "svc-1", nil, nil, map[string]any{
+			"spec": map[string]any{"selector": map[string]any{"app": "web"}},
+		})},
+		"Deployment": {newItem("Deployment", "web", "dep-1", webLabels, nil, nil)},
+		"ReplicaSet": {
+			newItem("ReplicaSet", "web-new", "rs-1", webLabels, ownedBy("Deployment", "web", "dep-1"), map[string]any{
+				"spec": map[string]any{"replicas": int64(1)}, "status": map[string]any{"replicas": int64(1)},
+			}),
+			newItem("ReplicaSet", "web-old", "rs-0", webLabels, ownedBy("Deployment", "web", "dep-1"), map[string]any{
+				"spec": map[string]any{"replicas": int64(0)},
+			}),
+		},
+		"Pod": {
+			newItem("Pod", "web-new-abc", "pod-1", webLabels, ownedBy("ReplicaSet", "web-new", "rs-1"), map[string]any{
+				"status": map[string]any{"phase": "Running"},
+			}),
+			newItem("Pod", "db-0", "pod-2", map[string]string{"app": "db"}, ownedBy("Cluster", "db", "crd-1"), nil),
+		},
+	}
+
+	graph := buildNamespaceGraph("shop", resources, maxGraphNodes)
+
+	wantNodes := []GraphNode{
+		{ID: "Ingress/web", Kind: "Ingress", Name: "web"},
+		{ID: "Service/web", Kind: "Service", Name: "web"},
+		{ID: "Deployment/web", Kind: "Deployment", Name: "web"},
+		{ID: "ReplicaSet/web-new", Kind: "ReplicaSet", Name: "web-new"},
+		{ID: "Pod/web-new-abc", Kind: "Pod", Name: "web-new-abc", Status: "Running"},
+		{ID: "Pod/db-0", Kind: "Pod", Name: "db-0"},
+		{ID: "Cluster/db", Kind: "Cluster", Name: "db"},
+		{ID: "Service/api", Kind: "Service", Name: "api", Missing: true},
+	}
+	if !reflect.DeepEqual(graph.Nodes, wantNodes) {
+		t.Errorf("Nodes = %+v, want %+v", graph.Nodes, wantNodes)
+	}
+
+	wantEdges := []GraphEdge{
+		{From: "Cluster/db", To: "Pod/db-0", Type: graphEdgeOwns},
+		{From: "Deployment/web", To: "ReplicaSet/web-new", Type: graphEdgeOwns},
+		{From: "Ingress/web", To: "Service/api", Type: graphEdgeRoutes},
+		{From: "Ingress/web", To: "Service/web", Type: graphEdgeRoutes},
+		{From: "ReplicaSet/web-new", To: "Pod/web-new-abc", Type: graphEdgeOwns},
+		{From: "Service/web", To: "Pod/web-new-abc", Type: graphEdgeSelects},
+	}
+	if !reflect.DeepEqual(graph.Edges, wantEdges) {
+		t.Errorf("Edges = %+v, want %+v", graph.Edges, wantEdges)
+	}
+
+	t.Run("services not listed", func(t *testing.T) {
+		graph := buildNamespaceGraph("shop", map[string][]unstructured.Unstructured{"Ingress": resources["Ingress"]}, maxGraphNodes)
+		for _, node := range graph.Nodes {
+			if node.Missing {
+				t.Errorf("node %s marked missing although Services weren't listed", node.ID)
+			}
+		}
+	})
+
+	t.Run("node cap", func(t *testing.T) {
+		graph := buildNamespaceGraph("shop", resources, 3)
+		if !graph.Truncated || len(graph.Nodes) != 3 {
+			t.Fatalf("expected 3 nodes and truncated, got %d nodes, truncated %v", len(graph.Nodes), graph.Truncated)
+		}
+		for _, edge := range graph.Edges {
+			if edge.From == "ReplicaSet/web-new" || edge.To == "ReplicaSet/web-new" {
+				t.Errorf("edge %+v references a node beyond the cap", edge)
+			}
+		}
+	})
+}
+
+func TestListGraphResources(t *testing.T) {
+	ingressGVR := graphKinds[0].gvr
+	serviceGVR := graphKinds[1].gvr
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{ingressGVR: "IngressList", serviceGVR: "ServiceList", podGVR: "PodList"},
+		&unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": "web-abc", "namespace": "shop"},
+			"status":     map[string]any{"phase": "Running"},
+		}},
+	)
+
+	scheme := metadatafake.NewTestScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme,
+		&metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web", UID: "rs-1", OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Deployment", Name: "web", UID: "dep-1"},
+			}},
+		},
+	)
+
+	// Only Ingress, Service, and Pod are registered with the dynamic client, which panics on
+	// listing anything else, so the other kinds must come from the metadata client
+	resources, truncated, skipped, err := listGraphResources(context.Background(), dynamicClient, metadataClient, "shop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if truncated || len(skipped) != 0 {
+		t.Errorf("expected a complete listing, got truncated %v, skipped %+v", truncated, skipped)
+	}
+	if pods := resources["Pod"]; len(pods) != 1 || pods[0].Object["status"] == nil {
+		t.Errorf("expected the full pod, got %+v", pods)
+	}
+	replicaSets := resources["ReplicaSet"]
+	if len(replicaSets) != 1 || len(replicaSets[0].GetOwnerReferences()) != 1 || replicaSets[0].GetUID() != "rs-1" {
+		t.Errorf("expected the ReplicaSet's metadata with its owner, got %+v", replicaSets)
+	}
+}
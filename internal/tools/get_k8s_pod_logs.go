@@ -1,15 +1,20 @@
 package tools
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/krmcbride/mcp-k8s/internal/k8s"
 )
@@ -22,11 +27,34 @@ type getPodLogsParams struct {
 	Since     string
 	SinceTime string
 	Tail      int64
+	Head      int64 // First lines to keep, trimmed client-side after reading the full log; only used by get_k8s_pod_logs
 	Previous  bool
+	// SinceLastRestart limits logs to the container's current run; only used by get_k8s_pod_logs
+	SinceLastRestart bool
+	// IncludeCurrent adds the current instance's logs after the previous instance's; only used by get_k8s_pod_logs
+	IncludeCurrent bool
 }
 
+// DefaultMaxLogBytes caps how much of a container's log is read per request, so full-log and head
+// reads of chatty containers don't buffer unbounded output
+const DefaultMaxLogBytes = 10 * 1024 * 1024
+
+// maxLogBytes is the most log data read per log stream; 0 disables the cap
+var maxLogBytes = DefaultMaxLogBytes
+
+// SetMaxLogBytes sets the maximum log size read per stream. A value of 0 or less disables the cap.
+func SetMaxLogBytes(n int) {
+	maxLogBytes = n
+}
+
+// Section headers separating the two instances when previous and current logs are returned together
+const (
+	previousLogsHeader = "==== previous (terminated) container instance ===="
+	currentLogsHeader  = "==== current container instance ===="
+)
+
 func RegisterGetK8sPodLogsMCPTool(s *server.MCPServer) {
-	s.AddTool(newGetK8sPodLogsMCPTool(), getK8sPodLogsHandler)
+	addTool(s, newGetK8sPodLogsMCPTool(), getK8sPodLogsHandler)
 }
 
 // Tool schema
@@ -45,8 +73,8 @@ func newGetK8sPodLogsMCPTool() mcp.Tool {
 			mcp.Description("The name of the pod to get logs from."),
 			mcp.Required(),
 		),
-		mcp.WithString("container",
-			mcp.Description("Optional container name. If not specified, uses the first container."),
+		mcp.WithString(containerProperty,
+			mcp.Description("Optional container name. If not specified, uses the first container. Init containers and ephemeral debug containers (from kubectl debug) can also be named."),
 		),
 		mcp.WithString("since",
 			mcp.Description("Return logs since a relative time (e.g., '5m', '1h', '30s'). Cannot be used with sinceTime."),
@@ -55,11 +83,24 @@ func newGetK8sPodLogsMCPTool() mcp.Tool {
 			mcp.Description("Return logs since an RFC3339 timestamp. Cannot be used with since."),
 		),
 		mcp.WithNumber("tail",
-			mcp.Description("Number of lines to return from the end of the log. Defaults to 10."),
+			mcp.Description("Number of lines to return from the end of the log. Defaults to 10, or to no tail lines when head is set. Use 0 or -1 to return the entire log."),
+		),
+		mcp.WithNumber("head",
+			mcp.Description("Number of lines to return from the start of the log, e.g. to see startup errors. Combined with tail, returns the first head and last tail lines "+
+				"with a marker showing how many lines were omitted in between. The log is read from the start up to the server's maximum log size; "+
+				"beyond that the tail is fetched separately and the marker can't count the omitted lines, so pair head with since or sinceTime for very long-running containers."),
 		),
 		mcp.WithBoolean("previous",
 			mcp.Description("Return logs from the previous terminated container instance."),
 		),
+		mcp.WithBoolean("includeCurrent",
+			mcp.Description("With previous=true, also return the current instance's logs in the same response, after the previous instance's logs and clearly separated. "+
+				"Useful for diagnosing a crash-restart in one call."),
+		),
+		mcp.WithBoolean("sinceLastRestart",
+			mcp.Description("Return only logs from the container's current run, starting when it last (re)started according to the pod status. "+
+				"Cannot be used with since, sinceTime, or previous; use previous to read the run that ended in the crash."),
+		),
 	)...)
 }
 
@@ -83,52 +124,272 @@ func getK8sPodLogsHandler(ctx context.Context, request mcp.CallToolRequest) (*mc
 	}
 
 	// Build log options
-	logOptions := &corev1.PodLogOptions{
-		Previous: params.Previous,
+	logOptions, err := buildPodLogOptions(params)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	if params.Container != "" {
-		logOptions.Container = params.Container
+	// Start the logs at the container's last restart
+	if params.SinceLastRestart {
+		restartTime, err := containerLastRestartTime(ctx, clientset, params.Namespace, params.Name, params.Container)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to determine last restart: %v", err)), nil
+		}
+		sinceTime := metav1.NewTime(restartTime)
+		logOptions.SinceTime = &sinceTime
 	}
 
-	if params.Tail > 0 {
-		logOptions.TailLines = &params.Tail
+	// Get pod logs, keeping only the requested head and tail lines of each instance
+	var logData string
+	if params.IncludeCurrent {
+		logData, err = readPreviousAndCurrentLogs(ctx, clientset, params.Namespace, params.Name, logOptions, params.Head, params.Tail)
+	} else {
+		logData, err = readTrimmedPodLogs(ctx, clientset, params.Namespace, params.Name, logOptions, params.Head, params.Tail)
 	}
-
-	// Handle since/sinceTime
-	if params.Since != "" {
-		duration, parseErr := parseDuration(params.Since)
-		if parseErr != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Invalid 'since' duration: %v", parseErr)), nil
-		}
-		logOptions.SinceSeconds = &duration
-	} else if params.SinceTime != "" {
-		sinceTime, parseErr := time.Parse(time.RFC3339, params.SinceTime)
-		if parseErr != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Invalid 'sinceTime' format (expected RFC3339): %v", parseErr)), nil
+	if err != nil {
+		// Point out the valid names if the container doesn't exist in the pod
+		if params.Container != "" {
+			if containerErr := checkPodContainer(ctx, clientset, params.Namespace, params.Name, params.Container); containerErr != nil {
+				return mcp.NewToolResultError(containerErr.Error()), nil
+			}
 		}
-		metaTime := metav1.NewTime(sinceTime)
-		logOptions.SinceTime = &metaTime
+		return mcp.NewToolResultError(categorizeK8sError("get", podGVR, params.Namespace, params.Name, err).Error()), nil
+	}
+
+	// Return logs as text
+	return sizeGuardedToolResult(logData, logTruncationHint), nil
+}
+
+// readPodLogs streams and reads the logs of a single pod, ending with a truncation marker if the
+// log exceeds maxLogBytes
+func readPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace, name string, logOptions *corev1.PodLogOptions) (string, error) {
+	logData, truncated, err := readPodLogsLimited(ctx, clientset, namespace, name, logOptions)
+	if truncated {
+		logData = appendLogTruncatedMarker(logData)
 	}
+	return logData, err
+}
 
-	// Get pod logs
-	req := clientset.CoreV1().Pods(params.Namespace).GetLogs(params.Name, logOptions)
+// readPodLogsLimited streams and reads up to maxLogBytes of a single pod's logs, cut back to the
+// last complete line, and reports whether the log was truncated
+func readPodLogsLimited(ctx context.Context, clientset kubernetes.Interface, namespace, name string, logOptions *corev1.PodLogOptions) (string, bool, error) {
+	// Ask for one byte more than the cap so reaching it can be detected. The options are copied
+	// since callers share them across concurrent streams.
+	options := *logOptions
+	if maxLogBytes > 0 {
+		limitBytes := int64(maxLogBytes) + 1
+		options.LimitBytes = &limitBytes
+	}
+
+	req := clientset.CoreV1().Pods(namespace).GetLogs(name, &options)
 	logs, err := req.Stream(ctx)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod logs: %v", err)), nil
+		return "", false, fmt.Errorf("get pod logs: %w", err)
 	}
 	defer func() {
 		_ = logs.Close() // Ignore close error
 	}()
 
-	// Read logs
-	logData, err := io.ReadAll(logs)
+	// Also bound the read itself in case the server doesn't honor limitBytes
+	var reader io.Reader = logs
+	if maxLogBytes > 0 {
+		reader = io.LimitReader(logs, int64(maxLogBytes)+1)
+	}
+	logData, err := io.ReadAll(reader)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to read pod logs: %v", err)), nil
+		return "", false, fmt.Errorf("read pod logs: %w", err)
 	}
 
-	// Return logs as text
-	return mcp.NewToolResultText(string(logData)), nil
+	if maxLogBytes <= 0 || len(logData) <= maxLogBytes {
+		return string(logData), false, nil
+	}
+	logData = logData[:maxLogBytes]
+	if end := bytes.LastIndexByte(logData, '\n'); end >= 0 {
+		logData = logData[:end+1]
+	}
+	return string(logData), true, nil
+}
+
+// appendLogTruncatedMarker notes on its own line where reading a log stopped at maxLogBytes
+func appendLogTruncatedMarker(logs string) string {
+	if logs != "" && !strings.HasSuffix(logs, "\n") {
+		logs += "\n"
+	}
+	return logs + fmt.Sprintf("... [log truncated at the %d byte maximum; narrow it with tail, since, or sinceTime] ...\n", maxLogBytes)
+}
+
+// readTrimmedPodLogs reads a pod's logs, keeping the first head and last tail lines when head is
+// set. A log too large to read whole keeps the head lines that were read and fetches the tail
+// lines with a separate request, since they lie beyond the cap.
+func readTrimmedPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace, name string, logOptions *corev1.PodLogOptions, head, tail int64) (string, error) {
+	logs, truncated, err := readPodLogsLimited(ctx, clientset, namespace, name, logOptions)
+	if err != nil {
+		return "", err
+	}
+	if !truncated {
+		return trimLogLines(logs, head, tail), nil
+	}
+	if head <= 0 || tail <= 0 {
+		if head > 0 {
+			logs = firstLogLines(logs, head)
+		}
+		return appendLogTruncatedMarker(logs), nil
+	}
+
+	tailOptions := *logOptions
+	tailOptions.TailLines = &tail
+	tailLogs, tailTruncated, err := readPodLogsLimited(ctx, clientset, namespace, name, &tailOptions)
+	if err != nil {
+		return "", err
+	}
+
+	if tailTruncated {
+		tailLogs = appendLogTruncatedMarker(tailLogs)
+	}
+
+	var b strings.Builder
+	b.WriteString(firstLogLines(logs, head))
+	fmt.Fprintf(&b, "... [middle of log omitted; it exceeds the %d byte maximum] ...\n", maxLogBytes)
+	b.WriteString(tailLogs)
+	return b.String(), nil
+}
+
+// readPreviousAndCurrentLogs reads the previous instance's logs followed by the current
+// instance's under separate headers, keeping the head and tail lines of each. The previous logs
+// are required; a failure reading the current logs (e.g. the container is waiting in
+// CrashLoopBackOff) is reported in its section.
+func readPreviousAndCurrentLogs(ctx context.Context, clientset kubernetes.Interface, namespace, name string, logOptions *corev1.PodLogOptions, head, tail int64) (string, error) {
+	previousOptions := *logOptions
+	previousOptions.Previous = true
+	previousLogs, err := readTrimmedPodLogs(ctx, clientset, namespace, name, &previousOptions, head, tail)
+	if err != nil {
+		return "", err
+	}
+
+	currentOptions := *logOptions
+	currentOptions.Previous = false
+	currentLogs, err := readTrimmedPodLogs(ctx, clientset, namespace, name, &currentOptions, head, tail)
+	if err != nil {
+		currentLogs = fmt.Sprintf("(unavailable: %v)\n", err)
+	}
+
+	var b strings.Builder
+	b.WriteString(previousLogsHeader + "\n")
+	b.WriteString(previousLogs)
+	if previousLogs != "" && !strings.HasSuffix(previousLogs, "\n") {
+		b.WriteString("\n")
+	}
+	b.WriteString(currentLogsHeader + "\n")
+	b.WriteString(currentLogs)
+	return b.String(), nil
+}
+
+// trimLogLines keeps the first head lines and, when tail is positive, the last tail lines of a
+// log, replacing the lines in between with a marker. The log is returned unchanged when head is
+// not positive (the tail was already applied server-side) or nothing would be omitted.
+func trimLogLines(logs string, head, tail int64) string {
+	if head <= 0 {
+		return logs
+	}
+	tail = max(tail, 0)
+
+	lines := strings.SplitAfter(logs, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	total := int64(len(lines))
+	if total <= head+tail {
+		return logs
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(lines[:head], ""))
+	if !strings.HasSuffix(lines[head-1], "\n") {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "... [%d lines omitted] ...\n", total-head-tail)
+	b.WriteString(strings.Join(lines[total-tail:], ""))
+	return b.String()
+}
+
+// firstLogLines returns the first n lines of a log, ending with a newline
+func firstLogLines(logs string, n int64) string {
+	lines := strings.SplitAfter(logs, "\n")
+	if int64(len(lines)) > n {
+		lines = lines[:n]
+	}
+	first := strings.Join(lines, "")
+	if first != "" && !strings.HasSuffix(first, "\n") {
+		first += "\n"
+	}
+	return first
+}
+
+// containerLastRestartTime returns when a container's current run started, from
+// state.running.startedAt, falling back to lastState.terminated.finishedAt while it is waiting
+// to restart. An empty container name selects the first container, like kubectl logs.
+func containerLastRestartTime(ctx context.Context, clientset kubernetes.Interface, namespace, name, container string) (time.Time, error) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get pod: %w", err)
+	}
+
+	if container == "" {
+		if len(pod.Spec.Containers) == 0 {
+			return time.Time{}, fmt.Errorf("pod %s/%s has no containers", namespace, name)
+		}
+		container = pod.Spec.Containers[0].Name
+	}
+
+	statuses := slices.Concat(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses, pod.Status.EphemeralContainerStatuses)
+	for _, status := range statuses {
+		if status.Name != container {
+			continue
+		}
+		switch {
+		case status.State.Running != nil && !status.State.Running.StartedAt.IsZero():
+			return status.State.Running.StartedAt.Time, nil
+		case status.LastTerminationState.Terminated != nil && !status.LastTerminationState.Terminated.FinishedAt.IsZero():
+			return status.LastTerminationState.Terminated.FinishedAt.Time, nil
+		case status.State.Terminated != nil && !status.State.Terminated.StartedAt.IsZero():
+			return status.State.Terminated.StartedAt.Time, nil
+		}
+		return time.Time{}, fmt.Errorf("container %q has not started yet", container)
+	}
+
+	return time.Time{}, fmt.Errorf("no status found for container %q in pod %s/%s", container, namespace, name)
+}
+
+// checkPodContainer returns an error listing the pod's containers, including init and ephemeral
+// containers, if the named container doesn't exist. Lookup failures are ignored.
+func checkPodContainer(ctx context.Context, clientset kubernetes.Interface, namespace, name, container string) error {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+
+	var containers, initContainers, ephemeralContainers []string
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Name)
+	}
+	for _, c := range pod.Spec.InitContainers {
+		initContainers = append(initContainers, c.Name)
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		ephemeralContainers = append(ephemeralContainers, c.Name)
+	}
+	if slices.Contains(containers, container) || slices.Contains(initContainers, container) || slices.Contains(ephemeralContainers, container) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("container %q not found in pod %s/%s. Containers: %s", container, namespace, name, strings.Join(containers, ", "))
+	if len(initContainers) > 0 {
+		msg += "; init containers: " + strings.Join(initContainers, ", ")
+	}
+	if len(ephemeralContainers) > 0 {
+		msg += "; ephemeral containers: " + strings.Join(ephemeralContainers, ", ")
+	}
+	return errors.New(msg)
 }
 
 func extractGetK8sPodLogsParams(request mcp.CallToolRequest) (*getPodLogsParams, error) {
@@ -147,21 +408,81 @@ func extractGetK8sPodLogsParams(request mcp.CallToolRequest) (*getPodLogsParams,
 		return nil, err
 	}
 
-	// Handle tail parameter - default to 10
-	tail := int64(request.GetInt("tail", 10))
+	// Handle head and tail parameters - tail defaults to 10 unless only head is requested
+	head := int64(request.GetInt("head", 0))
+	if head < 0 {
+		return nil, fmt.Errorf("'head' must not be negative")
+	}
+	defaultTail := 10
+	if head > 0 {
+		defaultTail = 0
+	}
+	tail := int64(request.GetInt("tail", defaultTail))
+
+	since := request.GetString("since", "")
+	sinceTime := request.GetString("sinceTime", "")
+	previous := request.GetBool("previous", false)
+	sinceLastRestart := request.GetBool("sinceLastRestart", false)
+	if sinceLastRestart && (since != "" || sinceTime != "" || previous) {
+		return nil, fmt.Errorf("'sinceLastRestart' cannot be used with 'since', 'sinceTime', or 'previous'")
+	}
+	includeCurrent := request.GetBool("includeCurrent", false)
+	if includeCurrent && !previous {
+		return nil, fmt.Errorf("'includeCurrent' requires 'previous' to be true")
+	}
 
 	return &getPodLogsParams{
-		Context:   context,
-		Namespace: namespace,
-		Name:      name,
-		Container: request.GetString("container", ""),
-		Since:     request.GetString("since", ""),
-		SinceTime: request.GetString("sinceTime", ""),
-		Tail:      tail,
-		Previous:  request.GetBool("previous", false),
+		Context:          context,
+		Namespace:        namespace,
+		Name:             name,
+		Container:        request.GetString(containerProperty, ""),
+		Since:            since,
+		SinceTime:        sinceTime,
+		Tail:             tail,
+		Head:             head,
+		Previous:         previous,
+		SinceLastRestart: sinceLastRestart,
+		IncludeCurrent:   includeCurrent,
 	}, nil
 }
 
+// buildPodLogOptions converts the tool parameters into PodLogOptions.
+// A tail of zero or less leaves TailLines unset so the full log is returned. When head is set the
+// full log is also requested, since head and tail are then both trimmed client-side; either way
+// readPodLogsLimited caps the read at maxLogBytes.
+func buildPodLogOptions(params *getPodLogsParams) (*corev1.PodLogOptions, error) {
+	logOptions := &corev1.PodLogOptions{
+		Previous: params.Previous,
+	}
+
+	if params.Container != "" {
+		logOptions.Container = params.Container
+	}
+
+	if params.Tail > 0 && params.Head <= 0 {
+		tail := params.Tail
+		logOptions.TailLines = &tail
+	}
+
+	// Handle since/sinceTime
+	if params.Since != "" {
+		duration, err := parseDuration(params.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' duration: %w", err)
+		}
+		logOptions.SinceSeconds = &duration
+	} else if params.SinceTime != "" {
+		sinceTime, err := time.Parse(time.RFC3339, params.SinceTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'sinceTime' format (expected RFC3339): %w", err)
+		}
+		metaTime := metav1.NewTime(sinceTime)
+		logOptions.SinceTime = &metaTime
+	}
+
+	return logOptions, nil
+}
+
 // parseDuration converts duration strings like "5m", "1h", "30s" to seconds
 func parseDuration(durationStr string) (int64, error) {
 	if durationStr == "" {
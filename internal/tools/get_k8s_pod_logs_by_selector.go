@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// maxConcurrentLogStreams caps how many pod log streams are read at once
+const maxConcurrentLogStreams = 10
+
+type getPodLogsBySelectorParams struct {
+	getPodLogsParams
+	LabelSelector string
+}
+
+// podLogsBySelectorContent maps each matching pod to its logs, with per-pod errors reported separately
+type podLogsBySelectorContent struct {
+	Pods   map[string]string `json:"pods"`
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+func RegisterGetK8sPodLogsBySelectorMCPTool(s *server.MCPServer) {
+	addTool(s, newGetK8sPodLogsBySelectorMCPTool(), getK8sPodLogsBySelectorHandler)
+}
+
+// Tool schema
+func newGetK8sPodLogsBySelectorMCPTool() mcp.Tool {
+	return mcp.NewTool("get_k8s_pod_logs_by_selector", readOnlyToolOptions(
+		mcp.WithDescription("Get logs from all pods matching a label selector, similar to kubectl logs -l app=x. Returns a map of pod name to logs."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace of the pods."),
+			mcp.Required(),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Label selector for the pods to get logs from (e.g., 'app=nginx')."),
+			mcp.Required(),
+		),
+		mcp.WithString(containerProperty,
+			mcp.Description("Optional container name. If not specified, uses the first container of each pod."),
+		),
+		mcp.WithString("since",
+			mcp.Description("Return logs since a relative time (e.g., '5m', '1h', '30s'). Cannot be used with sinceTime."),
+		),
+		mcp.WithString("sinceTime",
+			mcp.Description("Return logs since an RFC3339 timestamp. Cannot be used with since."),
+		),
+		mcp.WithNumber("tail",
+			mcp.Description("Number of lines to return from the end of each pod's log. Defaults to 10. Use 0 or -1 to return the entire log."),
+		),
+		mcp.WithBoolean("previous",
+			mcp.Description("Return logs from the previous terminated container instance."),
+		),
+	)...)
+}
+
+// Tool handler
+func getK8sPodLogsBySelectorHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractGetK8sPodLogsBySelectorParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Validate mutual exclusion of since and sinceTime
+	if params.Since != "" && params.SinceTime != "" {
+		return mcp.NewToolResultError("cannot specify both 'since' and 'sinceTime' parameters"), nil
+	}
+
+	// Build log options
+	logOptions, err := buildPodLogOptions(&params.getPodLogsParams)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get Kubernetes clientset for pod logs
+	clientset, err := k8s.GetClientsetForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create Kubernetes clientset: %v", err)), nil
+	}
+
+	// List matching pods
+	pods, err := clientset.CoreV1().Pods(params.Namespace).List(ctx, metav1.ListOptions{LabelSelector: params.LabelSelector})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods: %v", err)), nil
+	}
+	if len(pods.Items) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("No pods found matching label selector '%s' in namespace '%s'", params.LabelSelector, params.Namespace)), nil
+	}
+
+	podNames := make([]string, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		podNames = append(podNames, pod.Name)
+	}
+
+	return toJSONToolResult(collectPodLogs(ctx, clientset, params.Namespace, podNames, logOptions))
+}
+
+// collectPodLogs fetches logs from the given pods concurrently with a bounded number of
+// streams, recording failures per pod rather than failing the whole call
+func collectPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace string, podNames []string, logOptions *corev1.PodLogOptions) podLogsBySelectorContent {
+	content := podLogsBySelectorContent{Pods: map[string]string{}}
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLogStreams)
+	for _, podName := range podNames {
+		g.Go(func() error {
+			logData, err := readPodLogs(gctx, clientset, namespace, podName, logOptions)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if content.Errors == nil {
+					content.Errors = map[string]string{}
+				}
+				content.Errors[podName] = err.Error()
+				return nil
+			}
+			content.Pods[podName] = logData
+			return nil
+		})
+	}
+	_ = g.Wait() // Per-pod errors are collected in content.Errors
+
+	return content
+}
+
+func extractGetK8sPodLogsBySelectorParams(request mcp.CallToolRequest) (*getPodLogsBySelectorParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	labelSelector, err := request.RequireString(labelSelectorProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getPodLogsBySelectorParams{
+		getPodLogsParams: getPodLogsParams{
+			Context:   context,
+			Namespace: namespace,
+			Container: request.GetString(containerProperty, ""),
+			Since:     request.GetString("since", ""),
+			SinceTime: request.GetString("sinceTime", ""),
+			Tail:      int64(request.GetInt("tail", 10)),
+			Previous:  request.GetBool("previous", false),
+		},
+		LabelSelector: labelSelector,
+	}, nil
+}
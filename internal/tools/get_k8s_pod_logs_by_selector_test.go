@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCollectPodLogs(t *testing.T) {
+	// More pods than the concurrency cap so the worker pool is exercised
+	var objects []runtime.Object
+	var podNames []string
+	for i := range maxConcurrentLogStreams * 2 {
+		name := fmt.Sprintf("web-%d", i)
+		podNames = append(podNames, name)
+		objects = append(objects, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+	}
+	clientset := fake.NewClientset(objects...)
+
+	content := collectPodLogs(context.Background(), clientset, "default", podNames, &corev1.PodLogOptions{})
+
+	if len(content.Pods) != len(podNames) {
+		t.Errorf("expected logs for %d pods, got %d", len(podNames), len(content.Pods))
+	}
+	if len(content.Errors) != 0 {
+		t.Errorf("expected no errors, got %v", content.Errors)
+	}
+	for _, name := range podNames {
+		if content.Pods[name] == "" {
+			t.Errorf("expected logs for pod %s", name)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package tools
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestBuildPodLogOptionsTail(t *testing.T) {
+	tests := []struct {
+		name     string
+		tail     int64
+		expected *int64 // nil means the full log is requested
+	}{
+		{name: "negative returns full log", tail: -1, expected: nil},
+		{name: "zero returns full log", tail: 0, expected: nil},
+		{name: "one line", tail: 1, expected: ptrTo(int64(1))},
+		{name: "default", tail: 10, expected: ptrTo(int64(10))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logOptions, err := buildPodLogOptions(&getPodLogsParams{Tail: tt.tail})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			switch {
+			case tt.expected == nil && logOptions.TailLines != nil:
+				t.Errorf("TailLines = %d, want unset", *logOptions.TailLines)
+			case tt.expected != nil && logOptions.TailLines == nil:
+				t.Errorf("TailLines unset, want %d", *tt.expected)
+			case tt.expected != nil && *logOptions.TailLines != *tt.expected:
+				t.Errorf("TailLines = %d, want %d", *logOptions.TailLines, *tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildPodLogOptionsSince(t *testing.T) {
+	logOptions, err := buildPodLogOptions(&getPodLogsParams{Since: "5m"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logOptions.SinceSeconds == nil || *logOptions.SinceSeconds != 300 {
+		t.Errorf("SinceSeconds = %v, want 300", logOptions.SinceSeconds)
+	}
+
+	if _, err := buildPodLogOptions(&getPodLogsParams{Since: "five minutes"}); err == nil {
+		t.Error("expected error for invalid since duration")
+	}
+
+	if _, err := buildPodLogOptions(&getPodLogsParams{SinceTime: "yesterday"}); err == nil {
+		t.Error("expected error for invalid sinceTime")
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func TestCheckPodContainer(t *testing.T) {
+	clientset := fake.NewClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers:      []corev1.Container{{Name: "init"}},
+			Containers:          []corev1.Container{{Name: "app"}, {Name: "sidecar"}},
+			EphemeralContainers: []corev1.EphemeralContainer{{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-x7k2p"}}},
+		},
+	})
+
+	tests := []struct {
+		container string
+		wantErr   bool
+	}{
+		{container: "app"},
+		{container: "init"},
+		{container: "debugger-x7k2p"},
+		{container: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.container, func(t *testing.T) {
+			err := checkPodContainer(context.Background(), clientset, "default", "web-1", tt.container)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), "ephemeral containers: debugger-x7k2p") {
+				t.Errorf("expected error to list ephemeral containers, got %q", err.Error())
+			}
+		})
+	}
+}
+
+func TestContainerLastRestartTime(t *testing.T) {
+	started := time.Date(2025, 6, 19, 10, 0, 0, 0, time.UTC)
+	finished := time.Date(2025, 6, 19, 9, 59, 50, 0, time.UTC)
+	clientset := fake.NewClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}, {Name: "worker"}, {Name: "pending"}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(started)}}},
+				{
+					Name:                 "worker",
+					State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(finished)}},
+				},
+				{Name: "pending", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+			},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		container string
+		want      time.Time
+		wantErr   bool
+	}{
+		{name: "default container running", container: "", want: started},
+		{name: "crash looping container", container: "worker", want: finished},
+		{name: "not started", container: "pending", wantErr: true},
+		{name: "unknown container", container: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := containerLastRestartTime(context.Background(), clientset, "default", "web-1", tt.container)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReadPreviousAndCurrentLogs(t *testing.T) {
+	clientset := fake.NewClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}})
+
+	got, err := readPreviousAndCurrentLogs(context.Background(), clientset, "default", "web-1", &corev1.PodLogOptions{Previous: true}, 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	previousIndex := strings.Index(got, previousLogsHeader)
+	currentIndex := strings.Index(got, currentLogsHeader)
+	if previousIndex != 0 || currentIndex <= previousIndex {
+		t.Fatalf("expected previous section before current section, got %q", got)
+	}
+	if strings.Count(got, "fake logs") != 2 {
+		t.Errorf("expected logs from both instances, got %q", got)
+	}
+
+	var previousFlags []bool
+	for _, action := range clientset.Actions() {
+		if logAction, ok := action.(clienttesting.GenericActionImpl); ok && logAction.Subresource == "log" {
+			previousFlags = append(previousFlags, logAction.Value.(*corev1.PodLogOptions).Previous)
+		}
+	}
+	if want := []bool{true, false}; !slices.Equal(previousFlags, want) {
+		t.Errorf("expected previous flags %v for the log requests, got %v", want, previousFlags)
+	}
+}
+
+func TestTrimLogLines(t *testing.T) {
+	logs := "line1\nline2\nline3\nline4\nline5\nline6\n"
+
+	tests := []struct {
+		name string
+		head int64
+		tail int64
+		want string
+	}{
+		{name: "no head leaves tail to the server", head: 0, tail: 2, want: logs},
+		{name: "head only", head: 2, want: "line1\nline2\n... [4 lines omitted] ...\n"},
+		{name: "head and tail", head: 2, tail: 1, want: "line1\nline2\n... [3 lines omitted] ...\nline6\n"},
+		{name: "nothing omitted", head: 3, tail: 3, want: logs},
+		{name: "head beyond log", head: 10, want: logs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trimLogLines(logs, tt.head, tt.tail); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("no trailing newline", func(t *testing.T) {
+		got := trimLogLines("a\nb\nc", 1, 1)
+		if want := "a\n... [1 lines omitted] ...\nc"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+}
+
+func TestReadPodLogsMaxLogBytes(t *testing.T) {
+	original := maxLogBytes
+	t.Cleanup(func() { SetMaxLogBytes(original) })
+
+	// The fake clientset always streams "fake logs", ignoring limitBytes, which exercises the read cap
+	logLimits := func(clientset *fake.Clientset) []int64 {
+		var limits []int64
+		for _, action := range clientset.Actions() {
+			if logAction, ok := action.(clienttesting.GenericActionImpl); ok && logAction.Subresource == "log" {
+				if limit := logAction.Value.(*corev1.PodLogOptions).LimitBytes; limit != nil {
+					limits = append(limits, *limit)
+				}
+			}
+		}
+		return limits
+	}
+
+	t.Run("within the cap", func(t *testing.T) {
+		clientset := fake.NewClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}})
+		got, err := readPodLogs(context.Background(), clientset, "default", "web-1", &corev1.PodLogOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "fake logs" {
+			t.Errorf("expected untruncated logs, got %q", got)
+		}
+		if limits := logLimits(clientset); len(limits) != 1 || limits[0] != int64(DefaultMaxLogBytes)+1 {
+			t.Errorf("expected limitBytes %d, got %v", DefaultMaxLogBytes+1, limits)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		SetMaxLogBytes(5)
+		clientset := fake.NewClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}})
+		got, err := readPodLogs(context.Background(), clientset, "default", "web-1", &corev1.PodLogOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(got, "fake \n... [log truncated at the 5 byte maximum") {
+			t.Errorf("expected truncated logs with a marker, got %q", got)
+		}
+	})
+
+	t.Run("head and tail beyond the cap", func(t *testing.T) {
+		SetMaxLogBytes(5)
+		clientset := fake.NewClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}})
+		got, err := readTrimmedPodLogs(context.Background(), clientset, "default", "web-1", &corev1.PodLogOptions{}, 1, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(got, "fake \n... [middle of log omitted; it exceeds the 5 byte maximum] ...\n") {
+			t.Errorf("expected head lines, then an omitted marker, got %q", got)
+		}
+
+		// The tail is fetched with a second, tail-limited request
+		var tailLines []int64
+		for _, action := range clientset.Actions() {
+			if logAction, ok := action.(clienttesting.GenericActionImpl); ok && logAction.Subresource == "log" {
+				if lines := logAction.Value.(*corev1.PodLogOptions).TailLines; lines != nil {
+					tailLines = append(tailLines, *lines)
+				}
+			}
+		}
+		if !slices.Equal(tailLines, []int64{1}) {
+			t.Errorf("expected one request for the last line, got tail lines %v", tailLines)
+		}
+	})
+}
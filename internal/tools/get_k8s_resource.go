@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -11,27 +12,63 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 
 	"github.com/krmcbride/mcp-k8s/internal/k8s"
 )
 
 const (
-	nameProperty       = "name"
-	goTemplateProperty = "go_template"
+	nameProperty             = "name"
+	goTemplateProperty       = "go_template"
+	outputProperty           = "output"
+	includeRelatedProp       = "includeRelated"
+	includeManagedFieldsProp = "includeManagedFields"
+	subresourceProperty      = "subresource"
+	statusOnlyProperty       = "statusOnly"
 )
 
+// subresourceScale is the read-only scale subresource served by scalable workloads
+const subresourceScale = "scale"
+
+// scaleGVK is the kind returned by the scale subresource regardless of the parent kind
+var scaleGVK = schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"}
+
+// lastAppliedConfigAnnotation holds a full copy of the object written by kubectl apply
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Supported values for the output property
+const (
+	outputMapped = "mapped"
+	outputJSON   = "json"
+	outputYAML   = "yaml"
+	outputDrift  = "drift"
+)
+
+// getK8sResourceBatchResult is a single entry of a multi-name get, holding either
+// the formatted resource or the error encountered while fetching it
+type getK8sResourceBatchResult struct {
+	Name     string `json:"name"`
+	Resource any    `json:"resource,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
 type getK8sResourceParams struct {
-	Context    string
-	Name       string
-	Namespace  string
-	Group      string
-	Version    string
-	Kind       string
-	GoTemplate string
+	Context              string
+	Names                []string
+	Namespace            string
+	Group                string
+	Version              string
+	Kind                 string
+	GoTemplate           string
+	Output               string
+	IncludeRelated       bool
+	IncludeManagedFields bool
+	Subresource          string
+	StatusOnly           bool
 }
 
 func RegisterGetK8sResourceMCPTool(s *server.MCPServer) {
-	s.AddTool(newGetK8sResourceMCPTool(), getK8sResourceHandler)
+	addTool(s, newGetK8sResourceMCPTool(), getK8sResourceHandler)
 }
 
 // Tool schema
@@ -43,24 +80,51 @@ func newGetK8sResourceMCPTool() mcp.Tool {
 			mcp.Required(),
 		),
 		mcp.WithString(nameProperty,
-			mcp.Description("The name of the resource to fetch."),
+			mcp.Description("The name of the resource to fetch. Multiple comma-separated names may be given to fetch several resources at once; each is returned in an array with a per-name error if it could not be fetched."),
 			mcp.Required(),
 		),
 		mcp.WithString(namespaceProperty,
 			mcp.Description("The Kubernetes namespace to use. Required for namespaced resources."),
 		),
 		mcp.WithString(groupProperty,
-			mcp.Description("The Kubernetes resource API Group."),
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
 		),
 		mcp.WithString(versionProperty,
-			mcp.Description("The Kubernetes resource API Version."),
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
 		),
 		mcp.WithString(kindProperty,
 			mcp.Description("The Kubernetes resource Kind."),
 			mcp.Required(),
 		),
 		mcp.WithString(goTemplateProperty,
-			mcp.Description("Optional Go template expression for formatting output (e.g., '{{.metadata.name}}: {{.status.phase}}')."),
+			mcp.Description("Optional Go template expression for formatting output (e.g., '{{.metadata.name}}: {{.status.phase}}'). Cannot be used with a non-default output format."),
+		),
+		mcp.WithString(outputProperty,
+			mcp.Description("Output format: 'mapped' (condensed structured output), 'json' (full resource as JSON), 'yaml' (full resource as YAML, like kubectl get -o yaml), "+
+				"or 'drift' (compact health report of status conditions and desired-vs-observed discrepancies such as spec.replicas vs status.readyReplicas). Defaults to 'mapped'."),
+			mcp.Enum(outputMapped, outputJSON, outputYAML, outputDrift),
+		),
+		mcp.WithBoolean(includeRelatedProp,
+			mcp.Description("Include related child resources for well-known kinds: Deployment (ReplicaSets, Pods), StatefulSet/DaemonSet/Job (Pods), CronJob (Jobs, Pods), Service (EndpointSlices, Pods). "+
+				"Returns the resource under 'resource' with children under 'related'. Only supported for a single name without go_template."),
+		),
+		mcp.WithBoolean(rawProperty,
+			mcp.Description("Return the full unstructured object instead of the condensed mapped content, for fields the mapper doesn't surface. "+
+				"Shorthand for output 'json'; combine with output 'yaml' for YAML. Cannot be used with go_template or 'drift' output."),
+		),
+		mcp.WithBoolean(includeManagedFieldsProp,
+			mcp.Description("Keep metadata.managedFields and the kubectl last-applied-configuration annotation in raw, json/yaml output and go_template input. "+
+				"They are stripped by default since they are large and rarely useful."),
+		),
+		mcp.WithString(subresourceProperty,
+			mcp.Description("Optional subresource to read instead of the resource itself. 'scale' returns the desired and current replica counts and selector "+
+				"for scalable kinds such as Deployment, StatefulSet, and ReplicaSet. Cannot be combined with 'drift' output or includeRelated."),
+			mcp.Enum(subresourceScale),
+		),
+		mcp.WithBoolean(statusOnlyProperty,
+			mcp.Description("Return only the resource's status block with its kind, name, namespace, and metadata.generation (to compare with status.observedGeneration), "+
+				"as JSON or, with output 'yaml', YAML. Use for health checks on status-heavy objects such as Nodes or large custom resources. "+
+				"Cannot be used with go_template, 'drift' output, includeRelated, or subresource."),
 		),
 	)...)
 }
@@ -80,11 +144,26 @@ func getK8sResourceHandler(ctx context.Context, request mcp.CallToolRequest) (*m
 		Kind:    params.Kind,
 	}
 
-	// Convert GVK to GVR
-	gvr, err := k8s.GVKToGVR(params.Context, gvk)
+	// Convert GVK to GVR, using the version the cluster actually serves
+	mapping, err := k8s.GVKToRESTMapping(params.Context, gvk)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
+	gvk, gvr := mapping.GroupVersionKind, mapping.Resource
+
+	// Distinguish an unsupported subresource from a missing object, which both return NotFound
+	var subresources []string
+	if params.Subresource != "" {
+		supported, err := k8s.HasSubresource(params.Context, gvr, params.Subresource)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to check subresource: %v", err)), nil
+		}
+		if !supported {
+			return mcp.NewToolResultError(fmt.Sprintf("%s does not have a '%s' subresource", gvr.GroupResource(), params.Subresource)), nil
+		}
+		subresources = []string{params.Subresource}
+		gvk = scaleGVK
+	}
 
 	// Get dynamic client
 	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
@@ -92,20 +171,18 @@ func getK8sResourceHandler(ctx context.Context, request mcp.CallToolRequest) (*m
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
 	}
 
+	// Fetch several resources at once if multiple names were given
+	if len(params.Names) > 1 {
+		return getK8sResourceBatch(ctx, dynamicClient, gvr, gvk, params, subresources...)
+	}
+
 	// Get the specific resource
-	var resource *unstructured.Unstructured
-	if params.Namespace == "" {
-		// Cluster-scoped resource
-		resource, err = dynamicClient.Resource(gvr).Get(ctx, params.Name, metav1.GetOptions{})
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to get resource: %v", err)), nil
-		}
-	} else {
-		// Namespaced resource
-		resource, err = dynamicClient.Resource(gvr).Namespace(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to get resource: %v", err)), nil
-		}
+	resource, err := getK8sResource(ctx, dynamicClient, gvr, params.Namespace, params.Names[0], subresources...)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("get", gvr, params.Namespace, params.Names[0], err).Error()), nil
+	}
+	if !params.IncludeManagedFields {
+		StripNoisyMetadata(resource)
 	}
 
 	// Apply Go template if provided
@@ -113,13 +190,135 @@ func getK8sResourceHandler(ctx context.Context, request mcp.CallToolRequest) (*m
 		return applyGoTemplate(resource, params.GoTemplate)
 	}
 
-	// Map to appropriate content structure using custom mappers
-	content := mapToK8sResourceContent(resource, gvk)
+	content := formatK8sResource(resource, gvk, params.Output, params.StatusOnly)
 
-	// Return as JSON
+	// Follow drill-down chains to child resources if requested
+	if params.IncludeRelated {
+		related, truncated, err := getRelatedResources(ctx, dynamicClient, resource)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get related resources: %v", err)), nil
+		}
+		relatedContent := map[string]any{
+			"resource": content,
+			"related":  related,
+		}
+		// Flag bounded lists so callers don't mistake a partial set of children for all of them
+		if len(truncated) > 0 {
+			relatedContent["truncated"] = truncated
+			relatedContent["note"] = fmt.Sprintf("Related %s lists were truncated at %d items. Use list_k8s_resources with a labelSelector to page through them.", strings.Join(truncated, ", "), maxListLimit)
+		}
+		content = relatedContent
+	}
+
+	if params.Output == outputYAML {
+		return toYAMLToolResult(content)
+	}
 	return toJSONToolResult(content)
 }
 
+// getK8sResourceBatch fetches each named resource, recording per-name errors
+// instead of failing the whole request
+func getK8sResourceBatch(ctx context.Context, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, params *getK8sResourceParams, subresources ...string) (*mcp.CallToolResult, error) {
+	results := make([]getK8sResourceBatchResult, 0, len(params.Names))
+
+	for _, name := range params.Names {
+		result := getK8sResourceBatchResult{Name: name}
+
+		resource, err := getK8sResource(ctx, dynamicClient, gvr, params.Namespace, name, subresources...)
+		if err != nil {
+			result.Error = categorizeK8sError("get", gvr, params.Namespace, name, err).Error()
+			results = append(results, result)
+			continue
+		}
+		if !params.IncludeManagedFields {
+			StripNoisyMetadata(resource)
+		}
+
+		switch {
+		case params.GoTemplate != "":
+			output, err := executeGoTemplate(resource, params.GoTemplate)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Resource = output
+			}
+		default:
+			result.Resource = formatK8sResource(resource, gvk, params.Output, params.StatusOnly)
+		}
+
+		results = append(results, result)
+	}
+
+	if params.Output == outputYAML {
+		return toYAMLToolResult(results)
+	}
+	return toJSONToolResult(results)
+}
+
+// formatK8sResource shapes a resource for the requested output format: its status block when
+// statusOnly, the full object for raw formats, a drift report, or the mapped content by default
+func formatK8sResource(resource *unstructured.Unstructured, gvk schema.GroupVersionKind, output string, statusOnly bool) any {
+	if statusOnly {
+		return statusOnlyContent(resource)
+	}
+
+	switch output {
+	case outputJSON, outputYAML:
+		return resource.Object
+	case outputDrift:
+		return buildDriftReport(resource)
+	default:
+		return mapToK8sResourceContent(resource, gvk)
+	}
+}
+
+// statusOnlyContent returns a resource's status block with just enough identity to tell results
+// apart. Resources without a status return an empty one.
+func statusOnlyContent(resource *unstructured.Unstructured) map[string]any {
+	status, found, _ := unstructured.NestedMap(resource.Object, "status")
+	if !found {
+		status = map[string]any{}
+	}
+
+	content := map[string]any{
+		"kind":   resource.GetKind(),
+		"name":   resource.GetName(),
+		"status": status,
+	}
+	if namespace := resource.GetNamespace(); namespace != "" {
+		content["namespace"] = namespace
+	}
+	if generation := resource.GetGeneration(); generation > 0 {
+		content["generation"] = generation
+	}
+	return content
+}
+
+// StripNoisyMetadata removes managedFields and the last-applied-configuration annotation, which
+// together can be larger than the rest of the object and waste tokens in full-object output.
+// Exported so the k8s:// resource template returns the same shape as get_k8s_resource.
+func StripNoisyMetadata(resource *unstructured.Unstructured) {
+	resource.SetManagedFields(nil)
+
+	annotations := resource.GetAnnotations()
+	if _, found := annotations[lastAppliedConfigAnnotation]; !found {
+		return
+	}
+	delete(annotations, lastAppliedConfigAnnotation)
+	resource.SetAnnotations(annotations)
+}
+
+// getK8sResource fetches a single resource, or one of its subresources when given, treating an
+// empty namespace as cluster-scoped
+func getK8sResource(ctx context.Context, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, namespace, name string, subresources ...string) (*unstructured.Unstructured, error) {
+	if namespace == "" {
+		// Cluster-scoped resource
+		return dynamicClient.Resource(gvr).Get(ctx, name, metav1.GetOptions{}, subresources...)
+	}
+	// Namespaced resource
+	return dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{}, subresources...)
+}
+
 func extractGetK8sResourceParams(request mcp.CallToolRequest) (*getK8sResourceParams, error) {
 	context, err := request.RequireString(contextProperty)
 	if err != nil {
@@ -131,36 +330,118 @@ func extractGetK8sResourceParams(request mcp.CallToolRequest) (*getK8sResourcePa
 		return nil, err
 	}
 
+	// Split comma-separated names for batch retrieval
+	names := splitCommaSeparated(name)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s must not be empty", nameProperty)
+	}
+
 	kind, err := request.RequireString(kindProperty)
 	if err != nil {
 		return nil, err
 	}
 
+	// Validate output format (default to mapped)
+	output := strings.ToLower(request.GetString(outputProperty, outputMapped))
+	switch output {
+	case outputMapped, outputJSON, outputYAML, outputDrift:
+	default:
+		return nil, fmt.Errorf("output must be one of '%s', '%s', '%s' or '%s', got '%s'", outputMapped, outputJSON, outputYAML, outputDrift, output)
+	}
+
+	goTemplate := request.GetString(goTemplateProperty, "")
+	if goTemplate != "" && output != outputMapped {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", goTemplateProperty, outputProperty)
+	}
+
+	// raw bypasses the mapper, returning the full object as JSON unless YAML was requested
+	if request.GetBool(rawProperty, false) {
+		switch {
+		case goTemplate != "":
+			return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", rawProperty, goTemplateProperty)
+		case output == outputDrift:
+			return nil, fmt.Errorf("'%s' cannot be combined with '%s' output", rawProperty, outputDrift)
+		case output == outputMapped:
+			output = outputJSON
+		}
+	}
+
+	includeRelated := request.GetBool(includeRelatedProp, false)
+	if includeRelated && (len(names) > 1 || goTemplate != "") {
+		return nil, fmt.Errorf("'%s' is only supported for a single name without '%s'", includeRelatedProp, goTemplateProperty)
+	}
+
+	subresource := strings.ToLower(request.GetString(subresourceProperty, ""))
+	switch subresource {
+	case "", subresourceScale:
+	default:
+		return nil, fmt.Errorf("%s must be '%s', got '%s'", subresourceProperty, subresourceScale, subresource)
+	}
+	if subresource != "" && (output == outputDrift || includeRelated) {
+		return nil, fmt.Errorf("'%s' cannot be combined with '%s' output or '%s'", subresourceProperty, outputDrift, includeRelatedProp)
+	}
+
+	statusOnly := request.GetBool(statusOnlyProperty, false)
+	if statusOnly && (goTemplate != "" || output == outputDrift || includeRelated || subresource != "") {
+		return nil, fmt.Errorf("'%s' cannot be combined with '%s', '%s' output, '%s', or '%s'", statusOnlyProperty, goTemplateProperty, outputDrift, includeRelatedProp, subresourceProperty)
+	}
+
 	return &getK8sResourceParams{
-		Context:    context,
-		Name:       name,
-		Namespace:  request.GetString(namespaceProperty, ""),
-		Group:      request.GetString(groupProperty, ""),
-		Version:    request.GetString(versionProperty, "v1"),
-		Kind:       kind,
-		GoTemplate: request.GetString(goTemplateProperty, ""),
+		Context:              context,
+		Names:                names,
+		Namespace:            request.GetString(namespaceProperty, ""),
+		Group:                request.GetString(groupProperty, ""),
+		Version:              request.GetString(versionProperty, ""),
+		Kind:                 kind,
+		GoTemplate:           goTemplate,
+		Output:               output,
+		IncludeRelated:       includeRelated,
+		IncludeManagedFields: request.GetBool(includeManagedFieldsProp, false),
+		Subresource:          subresource,
+		StatusOnly:           statusOnly,
 	}, nil
 }
 
 func applyGoTemplate(resource *unstructured.Unstructured, templateStr string) (*mcp.CallToolResult, error) {
+	output, err := executeGoTemplate(resource, templateStr)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Return the template output as text
+	return mcp.NewToolResultText(output), nil
+}
+
+func executeGoTemplate(resource *unstructured.Unstructured, templateStr string) (string, error) {
 	// Parse the Go template
 	tmpl, err := template.New("resource").Parse(templateStr)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to parse Go template: %v", err)), nil
+		return "", fmt.Errorf("failed to parse Go template: %w", err)
+	}
+
+	// Mask sensitive values first, since template output bypasses JSON redaction
+	object, err := redactObject(resource.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to redact resource: %w", err)
 	}
 
 	// Apply the template to the resource
 	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, resource.Object)
+	err = tmpl.Execute(&buf, object)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to execute Go template: %v", err)), nil
+		return "", fmt.Errorf("failed to execute Go template: %w", err)
 	}
 
-	// Return the template output as text
-	return mcp.NewToolResultText(buf.String()), nil
+	return buf.String(), nil
+}
+
+// splitCommaSeparated splits a comma-separated list, trimming whitespace and dropping empty entries
+func splitCommaSeparated(value string) []string {
+	var parts []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
 }
@@ -0,0 +1,208 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+type getK8sResourceSchemaParams struct {
+	Context string
+	Group   string
+	Version string
+	Kind    string
+	Path    string
+}
+
+// ResourceSchemaResult is the OpenAPI v3 schema of a CRD-backed kind at one served version
+type ResourceSchemaResult struct {
+	Kind     string         `json:"kind"`
+	Group    string         `json:"group"`
+	Version  string         `json:"version"`
+	CRD      string         `json:"crd"`
+	Scope    string         `json:"scope,omitempty"`
+	Versions []string       `json:"servedVersions,omitempty"`
+	Path     string         `json:"path,omitempty"`
+	Schema   map[string]any `json:"schema"`
+}
+
+func RegisterGetK8sResourceSchemaMCPTool(s *server.MCPServer) {
+	addTool(s, newGetK8sResourceSchemaMCPTool(), getK8sResourceSchemaHandler)
+}
+
+// Tool schema
+func newGetK8sResourceSchemaMCPTool() mcp.Tool {
+	return mcp.NewTool("get_k8s_resource_schema", readOnlyToolOptions(
+		mcp.WithDescription("Get the OpenAPI v3 schema of a CRD-backed kind from its CustomResourceDefinition (spec.versions[].schema.openAPIV3Schema) for the served version, "+
+			"including properties, required fields, enums, and descriptions. Use it to learn the shape of unfamiliar custom resources. "+
+			"For built-in kinds, or to browse one field at a time, use explain_k8s_resource."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The custom resource's API Group. If omitted, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The custom resource's API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The custom resource Kind."),
+			mcp.Required(),
+		),
+		mcp.WithString(pathProperty,
+			mcp.Description("Optional dot-separated field path to return only part of the schema (e.g., 'spec.endpoints' or 'servicemonitor.spec.endpoints'). "+
+				"Use it for large CRDs whose full schema would be truncated."),
+		),
+	)...)
+}
+
+// Tool handler
+func getK8sResourceSchemaHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractGetK8sResourceSchemaParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Resolve the served group/version and resource name, which together name the CRD
+	mapping, err := k8s.GVKToRESTMapping(params.Context, schema.GroupVersionKind{Group: params.Group, Version: params.Version, Kind: params.Kind})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	gvk := mapping.GroupVersionKind
+	if gvk.Group == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("%s is a built-in core kind, not a custom resource. Use explain_k8s_resource to describe its fields.", gvk.Kind)), nil
+	}
+	crdName := mapping.Resource.Resource + "." + gvk.Group
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	crd, err := getK8sResource(ctx, dynamicClient, crdGVR, "", crdName)
+	if apierrors.IsNotFound(err) {
+		return mcp.NewToolResultError(fmt.Sprintf("%s is not defined by a CustomResourceDefinition (no CRD named %s); it is likely a built-in or aggregated API kind. "+
+			"Use explain_k8s_resource to describe its fields.", gvk.GroupKind().String(), crdName)), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("get", crdGVR, "", crdName, err).Error()), nil
+	}
+
+	result, err := crdVersionSchema(crd, gvk.Version, params.Path)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result.Kind = gvk.Kind
+	result.Group = gvk.Group
+
+	// Return as JSON, skipping redaction: schemas hold no cluster data, and masking would garble
+	// the definitions of fields with names like password or token
+	jsonContent, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return sizeGuardedToolResult(string(jsonContent), schemaTruncationHint), nil
+}
+
+// crdVersionSchema extracts the openAPIV3Schema of a CRD version, narrowed to a field path
+func crdVersionSchema(crd *unstructured.Unstructured, version, path string) (*ResourceSchemaResult, error) {
+	result := &ResourceSchemaResult{
+		Version: version,
+		CRD:     crd.GetName(),
+		Path:    path,
+	}
+	result.Scope, _, _ = unstructured.NestedString(crd.Object, "spec", "scope")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	var versionSchema map[string]any
+	for _, v := range versions {
+		versionMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(versionMap, "name")
+		if served, _, _ := unstructured.NestedBool(versionMap, "served"); served {
+			result.Versions = append(result.Versions, name)
+		}
+		if name == version {
+			versionSchema, _, _ = unstructured.NestedMap(versionMap, "schema", "openAPIV3Schema")
+		}
+	}
+	if versionSchema == nil {
+		return nil, fmt.Errorf("CustomResourceDefinition %s has no openAPIV3Schema for version %s", crd.GetName(), version)
+	}
+
+	// Allow paths prefixed with the kind, like explain_k8s_resource
+	var fields []string
+	if path != "" {
+		fields = strings.Split(path, ".")
+		if strings.EqualFold(fields[0], kind) {
+			fields = fields[1:]
+		}
+	}
+
+	current := versionSchema
+	for i, field := range fields {
+		current = crdElementSchema(current)
+		properties, _ := current["properties"].(map[string]any)
+		fieldSchema, ok := properties[field].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("field %q does not exist in %s", field, strings.Join(append([]string{kind}, fields[:i]...), "."))
+		}
+		current = fieldSchema
+	}
+	result.Schema = current
+
+	return result, nil
+}
+
+// crdElementSchema steps through array items and map values to the schema of their elements,
+// since CRD schemas are inlined rather than referenced
+func crdElementSchema(fieldSchema map[string]any) map[string]any {
+	for {
+		if items, ok := fieldSchema["items"].(map[string]any); ok {
+			fieldSchema = items
+			continue
+		}
+		if additional, ok := fieldSchema["additionalProperties"].(map[string]any); ok {
+			fieldSchema = additional
+			continue
+		}
+		return fieldSchema
+	}
+}
+
+func extractGetK8sResourceSchemaParams(request mcp.CallToolRequest) (*getK8sResourceSchemaParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getK8sResourceSchemaParams{
+		Context: context,
+		Group:   request.GetString(groupProperty, ""),
+		Version: request.GetString(versionProperty, ""),
+		Kind:    kind,
+		Path:    request.GetString(pathProperty, ""),
+	}, nil
+}
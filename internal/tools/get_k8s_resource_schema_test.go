@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCRDVersionSchema(t *testing.T) {
+	endpointSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"port":     map[string]any{"type": "string", "description": "Name of the service port."},
+			"interval": map[string]any{"type": "string"},
+		},
+	}
+	crd := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "servicemonitors.monitoring.coreos.com"},
+		"spec": map[string]any{
+			"scope": "Namespaced",
+			"names": map[string]any{"kind": "ServiceMonitor"},
+			"versions": []any{
+				map[string]any{"name": "v1alpha1", "served": false},
+				map[string]any{
+					"name":   "v1",
+					"served": true,
+					"schema": map[string]any{"openAPIV3Schema": map[string]any{
+						"type":     "object",
+						"required": []any{"spec"},
+						"properties": map[string]any{
+							"spec": map[string]any{
+								"type":       "object",
+								"properties": map[string]any{"endpoints": map[string]any{"type": "array", "items": endpointSchema}},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}}
+
+	t.Run("full schema", func(t *testing.T) {
+		result, err := crdVersionSchema(crd, "v1", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.CRD != "servicemonitors.monitoring.coreos.com" || result.Scope != "Namespaced" || !reflect.DeepEqual(result.Versions, []string{"v1"}) {
+			t.Errorf("unexpected result metadata: %+v", result)
+		}
+		if !reflect.DeepEqual(result.Schema["required"], []any{"spec"}) {
+			t.Errorf("Schema = %v", result.Schema)
+		}
+	})
+
+	t.Run("path through array items", func(t *testing.T) {
+		result, err := crdVersionSchema(crd, "v1", "servicemonitor.spec.endpoints.port")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Schema["description"] != "Name of the service port." {
+			t.Errorf("Schema = %v", result.Schema)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		if _, err := crdVersionSchema(crd, "v1", "spec.selector"); err == nil {
+			t.Error("expected an error for an unknown field")
+		}
+	})
+
+	t.Run("version without schema", func(t *testing.T) {
+		if _, err := crdVersionSchema(crd, "v1alpha1", ""); err == nil {
+			t.Error("expected an error for a version without a schema")
+		}
+	})
+}
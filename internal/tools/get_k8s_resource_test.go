@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestStripNoisyMetadata(t *testing.T) {
+	resource := &unstructured.Unstructured{Object: map[string]any{}}
+	resource.SetName("web")
+	resource.SetAnnotations(map[string]string{
+		lastAppliedConfigAnnotation:         `{"apiVersion":"apps/v1","kind":"Deployment"}`,
+		"deployment.kubernetes.io/revision": "3",
+	})
+	resource.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}})
+
+	StripNoisyMetadata(resource)
+
+	if _, found, _ := unstructured.NestedFieldNoCopy(resource.Object, "metadata", "managedFields"); found {
+		t.Error("expected managedFields to be removed")
+	}
+	want := map[string]string{"deployment.kubernetes.io/revision": "3"}
+	if got := resource.GetAnnotations(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected annotations %v, got %v", want, got)
+	}
+}
+
+func TestExtractGetK8sResourceParamsRaw(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		want    string
+		wantErr bool
+	}{
+		{name: "raw defaults to json", args: map[string]any{"raw": true}, want: outputJSON},
+		{name: "raw with yaml", args: map[string]any{"raw": true, "output": "yaml"}, want: outputYAML},
+		{name: "raw with drift", args: map[string]any{"raw": true, "output": "drift"}, wantErr: true},
+		{name: "raw with go_template", args: map[string]any{"raw": true, "go_template": "{{.metadata.name}}"}, wantErr: true},
+		{name: "not raw", args: map[string]any{"raw": false}, want: outputMapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			args := map[string]any{"context": "prod", "kind": "Deployment", "group": "apps", "name": "web"}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			request.Params.Arguments = args
+
+			params, err := extractGetK8sResourceParams(request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got output %q", params.Output)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if params.Output != tt.want {
+				t.Errorf("expected output %q, got %q", tt.want, params.Output)
+			}
+		})
+	}
+}
+
+func TestExtractGetK8sResourceParamsSubresource(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		want    string
+		wantErr bool
+	}{
+		{name: "none", args: map[string]any{}},
+		{name: "scale", args: map[string]any{"subresource": "Scale"}, want: subresourceScale},
+		{name: "unsupported", args: map[string]any{"subresource": "status"}, wantErr: true},
+		{name: "with drift", args: map[string]any{"subresource": "scale", "output": "drift"}, wantErr: true},
+		{name: "with includeRelated", args: map[string]any{"subresource": "scale", "includeRelated": true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			args := map[string]any{"context": "prod", "kind": "Deployment", "group": "apps", "name": "web"}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			request.Params.Arguments = args
+
+			params, err := extractGetK8sResourceParams(request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got subresource %q", params.Subresource)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if params.Subresource != tt.want {
+				t.Errorf("expected subresource %q, got %q", tt.want, params.Subresource)
+			}
+		})
+	}
+}
+
+func TestExtractGetK8sResourceParamsStatusOnly(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		want    bool
+		wantErr bool
+	}{
+		{name: "default", args: map[string]any{}},
+		{name: "enabled", args: map[string]any{"statusOnly": true}, want: true},
+		{name: "with yaml", args: map[string]any{"statusOnly": true, "output": "yaml"}, want: true},
+		{name: "with go_template", args: map[string]any{"statusOnly": true, "go_template": "{{.status}}"}, wantErr: true},
+		{name: "with drift", args: map[string]any{"statusOnly": true, "output": "drift"}, wantErr: true},
+		{name: "with includeRelated", args: map[string]any{"statusOnly": true, "includeRelated": true}, wantErr: true},
+		{name: "with subresource", args: map[string]any{"statusOnly": true, "subresource": "scale"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			args := map[string]any{"context": "prod", "kind": "Deployment", "group": "apps", "name": "web"}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			request.Params.Arguments = args
+
+			params, err := extractGetK8sResourceParams(request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got statusOnly %v", params.StatusOnly)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if params.StatusOnly != tt.want {
+				t.Errorf("expected statusOnly %v, got %v", tt.want, params.StatusOnly)
+			}
+		})
+	}
+}
+
+func TestStatusOnlyContent(t *testing.T) {
+	resource := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "web", "namespace": "prod", "generation": int64(3)},
+		"spec":       map[string]any{"replicas": int64(2)},
+		"status":     map[string]any{"observedGeneration": int64(3), "readyReplicas": int64(2)},
+	}}
+
+	content := statusOnlyContent(resource)
+	if content["kind"] != "Deployment" || content["name"] != "web" || content["namespace"] != "prod" {
+		t.Errorf("unexpected identity: %v", content)
+	}
+	if content["generation"] != int64(3) {
+		t.Errorf("expected generation 3, got %v", content["generation"])
+	}
+	if _, ok := content["spec"]; ok {
+		t.Error("expected spec to be omitted")
+	}
+	status, ok := content["status"].(map[string]any)
+	if !ok || status["readyReplicas"] != int64(2) {
+		t.Errorf("unexpected status: %v", content["status"])
+	}
+
+	node := &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "Node",
+		"metadata": map[string]any{"name": "node-1"},
+	}}
+	content = statusOnlyContent(node)
+	if _, ok := content["namespace"]; ok {
+		t.Error("expected namespace to be omitted for cluster-scoped resources")
+	}
+	if _, ok := content["generation"]; ok {
+		t.Error("expected generation to be omitted when unset")
+	}
+	if status, ok := content["status"].(map[string]any); !ok || len(status) != 0 {
+		t.Errorf("expected empty status, got %v", content["status"])
+	}
+}
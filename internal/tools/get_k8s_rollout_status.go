@@ -0,0 +1,234 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// Workload kinds supported by get_k8s_rollout_status
+const (
+	rolloutKindDeployment  = "Deployment"
+	rolloutKindStatefulSet = "StatefulSet"
+	rolloutKindDaemonSet   = "DaemonSet"
+)
+
+type getK8sRolloutStatusParams struct {
+	Context   string
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// RolloutStatusResult reports the rollout status of a workload the same way kubectl rollout status does
+type RolloutStatusResult struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Status    string `json:"status"`
+	Done      bool   `json:"done"`
+}
+
+func RegisterGetK8sRolloutStatusMCPTool(s *server.MCPServer) {
+	addTool(s, newGetK8sRolloutStatusMCPTool(), getK8sRolloutStatusHandler)
+}
+
+// Tool schema
+func newGetK8sRolloutStatusMCPTool() mcp.Tool {
+	return mcp.NewTool("get_k8s_rollout_status", readOnlyToolOptions(
+		mcp.WithDescription("Get the rollout status of a Deployment, StatefulSet, or DaemonSet, similar to kubectl rollout status. Returns a human-readable status and whether the rollout is done. Does not wait; call again to poll."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace of the workload."),
+			mcp.Required(),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The workload kind."),
+			mcp.Required(),
+			mcp.Enum(rolloutKindDeployment, rolloutKindStatefulSet, rolloutKindDaemonSet),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("The name of the workload."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func getK8sRolloutStatusHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractGetK8sRolloutStatusParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get Kubernetes clientset
+	clientset, err := k8s.GetClientsetForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create Kubernetes clientset: %v", err)), nil
+	}
+
+	// Get the workload and compute its rollout status
+	var status string
+	var done bool
+	switch params.Kind {
+	case rolloutKindDeployment:
+		deployment, getErr := clientset.AppsV1().Deployments(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deployment: %v", getErr)), nil
+		}
+		status, done, err = deploymentRolloutStatus(deployment)
+	case rolloutKindStatefulSet:
+		statefulSet, getErr := clientset.AppsV1().StatefulSets(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get statefulset: %v", getErr)), nil
+		}
+		status, done, err = statefulSetRolloutStatus(statefulSet)
+	case rolloutKindDaemonSet:
+		daemonSet, getErr := clientset.AppsV1().DaemonSets(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get daemonset: %v", getErr)), nil
+		}
+		status, done, err = daemonSetRolloutStatus(daemonSet)
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get rollout status: %v", err)), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(RolloutStatusResult{
+		Kind:      params.Kind,
+		Name:      params.Name,
+		Namespace: params.Namespace,
+		Status:    status,
+		Done:      done,
+	})
+}
+
+func extractGetK8sRolloutStatusParams(request mcp.CallToolRequest) (*getK8sRolloutStatusParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalize kind so "deployment" and "Deployment" are equivalent
+	switch strings.ToLower(kind) {
+	case strings.ToLower(rolloutKindDeployment):
+		kind = rolloutKindDeployment
+	case strings.ToLower(rolloutKindStatefulSet):
+		kind = rolloutKindStatefulSet
+	case strings.ToLower(rolloutKindDaemonSet):
+		kind = rolloutKindDaemonSet
+	default:
+		return nil, fmt.Errorf("unsupported kind '%s': must be one of %s, %s, %s", kind, rolloutKindDeployment, rolloutKindStatefulSet, rolloutKindDaemonSet)
+	}
+
+	name, err := request.RequireString(nameProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getK8sRolloutStatusParams{
+		Context:   context,
+		Namespace: namespace,
+		Kind:      kind,
+		Name:      name,
+	}, nil
+}
+
+// The status functions below mirror the rollout status viewers used by kubectl rollout status.
+// Each returns a status message, whether the rollout is done, and an error if the rollout cannot
+// progress or its status cannot be determined.
+
+func deploymentRolloutStatus(deployment *appsv1.Deployment) (string, bool, error) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return "Waiting for deployment spec update to be observed...", false, nil
+	}
+
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return "", false, fmt.Errorf("deployment %q exceeded its progress deadline", deployment.Name)
+		}
+	}
+
+	status := deployment.Status
+	if deployment.Spec.Replicas != nil && status.UpdatedReplicas < *deployment.Spec.Replicas {
+		return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated...", deployment.Name, status.UpdatedReplicas, *deployment.Spec.Replicas), false, nil
+	}
+	if status.Replicas > status.UpdatedReplicas {
+		return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d old replicas are pending termination...", deployment.Name, status.Replicas-status.UpdatedReplicas), false, nil
+	}
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available...", deployment.Name, status.AvailableReplicas, status.UpdatedReplicas), false, nil
+	}
+	return fmt.Sprintf("deployment %q successfully rolled out", deployment.Name), true, nil
+}
+
+func statefulSetRolloutStatus(statefulSet *appsv1.StatefulSet) (string, bool, error) {
+	if statefulSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		return "", true, fmt.Errorf("rollout status is only available for %s strategy type", appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+
+	status := statefulSet.Status
+	if status.ObservedGeneration == 0 || statefulSet.Generation > status.ObservedGeneration {
+		return "Waiting for statefulset spec update to be observed...", false, nil
+	}
+	if statefulSet.Spec.Replicas != nil && status.ReadyReplicas < *statefulSet.Spec.Replicas {
+		return fmt.Sprintf("Waiting for %d pods to be ready...", *statefulSet.Spec.Replicas-status.ReadyReplicas), false, nil
+	}
+
+	// Partitioned rollouts only update pods with an ordinal at or above the partition
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		if statefulSet.Spec.Replicas != nil && *rollingUpdate.Partition > 0 {
+			expected := *statefulSet.Spec.Replicas - *rollingUpdate.Partition
+			if status.UpdatedReplicas < expected {
+				return fmt.Sprintf("Waiting for partitioned roll out to finish: %d out of %d new pods have been updated...", status.UpdatedReplicas, expected), false, nil
+			}
+			return fmt.Sprintf("partitioned roll out complete: %d new pods have been updated...", status.UpdatedReplicas), true, nil
+		}
+	}
+
+	if status.UpdateRevision != status.CurrentRevision {
+		return fmt.Sprintf("waiting for statefulset rolling update to complete %d pods at revision %s...", status.UpdatedReplicas, status.UpdateRevision), false, nil
+	}
+	return fmt.Sprintf("statefulset rolling update complete %d pods at revision %s...", status.CurrentReplicas, status.CurrentRevision), true, nil
+}
+
+func daemonSetRolloutStatus(daemonSet *appsv1.DaemonSet) (string, bool, error) {
+	if daemonSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+		return "", true, fmt.Errorf("rollout status is only available for %s strategy type", appsv1.RollingUpdateDaemonSetStrategyType)
+	}
+
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return "Waiting for daemon set spec update to be observed...", false, nil
+	}
+
+	status := daemonSet.Status
+	if status.UpdatedNumberScheduled < status.DesiredNumberScheduled {
+		return fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d out of %d new pods have been updated...", daemonSet.Name, status.UpdatedNumberScheduled, status.DesiredNumberScheduled), false, nil
+	}
+	if status.NumberAvailable < status.DesiredNumberScheduled {
+		return fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d of %d updated pods are available...", daemonSet.Name, status.NumberAvailable, status.DesiredNumberScheduled), false, nil
+	}
+	return fmt.Sprintf("daemon set %q successfully rolled out", daemonSet.Name), true, nil
+}
@@ -0,0 +1,208 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		generation int64
+		status     appsv1.DeploymentStatus
+		wantStatus string
+		wantDone   bool
+		wantErr    bool
+	}{
+		{
+			name:       "spec update not observed",
+			generation: 2,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			wantStatus: "spec update to be observed",
+		},
+		{
+			name:       "replicas not updated",
+			generation: 1,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 1},
+			wantStatus: "1 out of 3 new replicas have been updated",
+		},
+		{
+			name:       "old replicas pending termination",
+			generation: 1,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 3},
+			wantStatus: "1 old replicas are pending termination",
+		},
+		{
+			name:       "updated replicas not available",
+			generation: 1,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+			wantStatus: "2 of 3 updated replicas are available",
+		},
+		{
+			name:       "rolled out",
+			generation: 1,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			wantStatus: "successfully rolled out",
+			wantDone:   true,
+		},
+		{
+			name:       "progress deadline exceeded",
+			generation: 1,
+			status: appsv1.DeploymentStatus{ObservedGeneration: 1, Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Generation: tt.generation},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptrTo[int32](3)},
+				Status:     tt.status,
+			}
+
+			status, done, err := deploymentRolloutStatus(deployment)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(status, tt.wantStatus) {
+				t.Errorf("expected status containing %q, got %q", tt.wantStatus, status)
+			}
+			if done != tt.wantDone {
+				t.Errorf("expected done=%v, got %v", tt.wantDone, done)
+			}
+		})
+	}
+}
+
+func TestStatefulSetRolloutStatus(t *testing.T) {
+	rollingUpdate := appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType}
+
+	tests := []struct {
+		name       string
+		strategy   appsv1.StatefulSetUpdateStrategy
+		status     appsv1.StatefulSetStatus
+		wantStatus string
+		wantDone   bool
+		wantErr    bool
+	}{
+		{
+			name:     "OnDelete strategy unsupported",
+			strategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+			wantErr:  true,
+		},
+		{
+			name:       "pods not ready",
+			strategy:   rollingUpdate,
+			status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 1},
+			wantStatus: "Waiting for 2 pods to be ready",
+		},
+		{
+			name: "partitioned rollout in progress",
+			strategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptrTo[int32](1)},
+			},
+			status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 1},
+			wantStatus: "1 out of 2 new pods have been updated",
+		},
+		{
+			name:       "revision mismatch",
+			strategy:   rollingUpdate,
+			status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 2, CurrentRevision: "web-1", UpdateRevision: "web-2"},
+			wantStatus: "waiting for statefulset rolling update to complete 2 pods at revision web-2",
+		},
+		{
+			name:       "complete",
+			strategy:   rollingUpdate,
+			status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentReplicas: 3, CurrentRevision: "web-2", UpdateRevision: "web-2"},
+			wantStatus: "rolling update complete 3 pods at revision web-2",
+			wantDone:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Generation: 1},
+				Spec:       appsv1.StatefulSetSpec{Replicas: ptrTo[int32](3), UpdateStrategy: tt.strategy},
+				Status:     tt.status,
+			}
+
+			status, done, err := statefulSetRolloutStatus(statefulSet)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(status, tt.wantStatus) {
+				t.Errorf("expected status containing %q, got %q", tt.wantStatus, status)
+			}
+			if done != tt.wantDone {
+				t.Errorf("expected done=%v, got %v", tt.wantDone, done)
+			}
+		})
+	}
+}
+
+func TestDaemonSetRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     appsv1.DaemonSetStatus
+		wantStatus string
+		wantDone   bool
+	}{
+		{
+			name:       "pods not updated",
+			status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 1},
+			wantStatus: "1 out of 3 new pods have been updated",
+		},
+		{
+			name:       "pods not available",
+			status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberAvailable: 2},
+			wantStatus: "2 of 3 updated pods are available",
+		},
+		{
+			name:       "rolled out",
+			status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberAvailable: 3},
+			wantStatus: "successfully rolled out",
+			wantDone:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "agent", Generation: 1},
+				Spec:       appsv1.DaemonSetSpec{UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType}},
+				Status:     tt.status,
+			}
+
+			status, done, err := daemonSetRolloutStatus(daemonSet)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(status, tt.wantStatus) {
+				t.Errorf("expected status containing %q, got %q", tt.wantStatus, status)
+			}
+			if done != tt.wantDone {
+				t.Errorf("expected done=%v, got %v", tt.wantDone, done)
+			}
+		})
+	}
+}
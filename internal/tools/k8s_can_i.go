@@ -0,0 +1,207 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+const verbProperty = "verb"
+
+type k8sCanIParams struct {
+	Context       string
+	Verb          string
+	Group         string
+	Version       string
+	Kind          string
+	Subresource   string
+	Name          string
+	Namespace     string
+	AllNamespaces bool
+}
+
+// CanIResult reports whether the current credentials may perform a verb on a resource
+type CanIResult struct {
+	Allowed         bool   `json:"allowed"`
+	Denied          bool   `json:"denied,omitempty"` // An authorizer explicitly denied the request rather than having no opinion
+	Verb            string `json:"verb"`
+	Resource        string `json:"resource"` // The resource as RBAC rules name it, e.g. "deployments.apps" or "pods/log"
+	Namespace       string `json:"namespace,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+	EvaluationError string `json:"evaluationError,omitempty"`
+}
+
+func RegisterK8sCanIMCPTool(s *server.MCPServer) {
+	addTool(s, newK8sCanIMCPTool(), k8sCanIHandler)
+}
+
+// Tool schema
+func newK8sCanIMCPTool() mcp.Tool {
+	return mcp.NewTool("k8s_can_i", readOnlyToolOptions(
+		mcp.WithDescription("Check whether the current credentials can perform a verb on a resource, like kubectl auth can-i. "+
+			"Uses a SelfSubjectAccessReview, which asks the API server's authorizers without changing cluster state. "+
+			"Use it to diagnose why a list or get returns forbidden."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(verbProperty,
+			mcp.Description("The verb to check, e.g. get, list, watch, create, update, patch, delete, or '*' for all verbs."),
+			mcp.Required(),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The Kubernetes resource Kind."),
+			mcp.Required(),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The Kubernetes resource API Version. Only used to resolve the kind; RBAC rules don't depend on the version."),
+		),
+		mcp.WithString(subresourceProperty,
+			mcp.Description("Optional subresource to check, e.g. 'log' for pods/log or 'scale' for deployments/scale."),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("Optional resource name, for rules that are restricted to specific resource names."),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to check. Defaults to the context's configured namespace. Ignored for cluster-scoped kinds."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("Check access across all namespaces (like kubectl auth can-i -A). Cannot be used with namespace."),
+		),
+	)...)
+}
+
+// Tool handler
+func k8sCanIHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractK8sCanIParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   params.Group,
+		Version: params.Version,
+		Kind:    params.Kind,
+	}
+
+	// Resolve the resource and its scope
+	mapping, err := k8s.GVKToRESTMapping(params.Context, gvk)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	namespace, err := resolveNamespace(params.Context, mapping, params.Namespace, params.AllNamespaces)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get Kubernetes clientset
+	clientset, err := k8s.GetClientsetForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create Kubernetes clientset: %v", err)), nil
+	}
+
+	result, err := checkAccess(ctx, clientset, authorizationv1.ResourceAttributes{
+		Namespace:   namespace,
+		Verb:        params.Verb,
+		Group:       mapping.Resource.Group,
+		Resource:    mapping.Resource.Resource,
+		Subresource: params.Subresource,
+		Name:        params.Name,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create self subject access review: %v", k8s.ClassifyClusterError(err))), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+// checkAccess asks the API server whether the current credentials may perform the described
+// action. SelfSubjectAccessReviews are evaluated and returned without being persisted.
+func checkAccess(ctx context.Context, clientset kubernetes.Interface, attributes authorizationv1.ResourceAttributes) (*CanIResult, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+	}
+	response, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CanIResult{
+		Allowed:         response.Status.Allowed,
+		Denied:          response.Status.Denied,
+		Verb:            attributes.Verb,
+		Resource:        rbacResourceName(attributes),
+		Namespace:       attributes.Namespace,
+		Name:            attributes.Name,
+		Reason:          response.Status.Reason,
+		EvaluationError: response.Status.EvaluationError,
+	}, nil
+}
+
+// rbacResourceName formats a resource the way RBAC rules and kubectl auth can-i name it,
+// e.g. "pods", "deployments.apps", or "pods/log"
+func rbacResourceName(attributes authorizationv1.ResourceAttributes) string {
+	resource := attributes.Resource
+	if attributes.Group != "" {
+		resource += "." + attributes.Group
+	}
+	if attributes.Subresource != "" {
+		resource += "/" + attributes.Subresource
+	}
+	return resource
+}
+
+func extractK8sCanIParams(request mcp.CallToolRequest) (*k8sCanIParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	verb, err := request.RequireString(verbProperty)
+	if err != nil {
+		return nil, err
+	}
+	verb = strings.ToLower(strings.TrimSpace(verb))
+	if verb == "" {
+		return nil, fmt.Errorf("%s must not be empty", verbProperty)
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	return &k8sCanIParams{
+		Context:       context,
+		Verb:          verb,
+		Group:         request.GetString(groupProperty, ""),
+		Version:       request.GetString(versionProperty, ""),
+		Kind:          kind,
+		Subresource:   strings.TrimSpace(request.GetString(subresourceProperty, "")),
+		Name:          request.GetString(nameProperty, ""),
+		Namespace:     namespace,
+		AllNamespaces: allNamespaces,
+	}, nil
+}
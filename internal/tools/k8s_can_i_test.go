@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestCheckAccess(t *testing.T) {
+	clientset := fake.NewClientset()
+	var reviewed authorizationv1.ResourceAttributes
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		reviewed = *review.Spec.ResourceAttributes
+		review.Status = authorizationv1.SubjectAccessReviewStatus{
+			Allowed: reviewed.Verb == "get",
+			Reason:  `RBAC: allowed by RoleBinding "readers/prod" of ClusterRole "view"`,
+		}
+		return true, review, nil
+	})
+
+	tests := []struct {
+		name         string
+		attributes   authorizationv1.ResourceAttributes
+		wantAllowed  bool
+		wantResource string
+	}{
+		{
+			name:         "core resource allowed",
+			attributes:   authorizationv1.ResourceAttributes{Namespace: "prod", Verb: "get", Resource: "pods"},
+			wantAllowed:  true,
+			wantResource: "pods",
+		},
+		{
+			name:         "grouped resource denied",
+			attributes:   authorizationv1.ResourceAttributes{Namespace: "prod", Verb: "delete", Group: "apps", Resource: "deployments", Name: "web"},
+			wantResource: "deployments.apps",
+		},
+		{
+			name:         "subresource",
+			attributes:   authorizationv1.ResourceAttributes{Namespace: "prod", Verb: "get", Resource: "pods", Subresource: "log"},
+			wantAllowed:  true,
+			wantResource: "pods/log",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := checkAccess(context.Background(), clientset, tt.attributes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if reviewed != tt.attributes {
+				t.Errorf("reviewed attributes = %+v, want %+v", reviewed, tt.attributes)
+			}
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Resource != tt.wantResource {
+				t.Errorf("Resource = %q, want %q", result.Resource, tt.wantResource)
+			}
+			if result.Verb != tt.attributes.Verb || result.Namespace != "prod" || result.Name != tt.attributes.Name || result.Reason == "" {
+				t.Errorf("unexpected result: %+v", result)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+type k8sResourceExistsParams struct {
+	Context   string
+	Name      string
+	Namespace string
+	Group     string
+	Version   string
+	Kind      string
+}
+
+// ResourceExistsResult reports whether a resource exists, with its identity when it does
+type ResourceExistsResult struct {
+	Exists          bool   `json:"exists"`
+	Kind            string `json:"kind"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace,omitempty"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	UID             string `json:"uid,omitempty"`
+}
+
+func RegisterK8sResourceExistsMCPTool(s *server.MCPServer) {
+	addTool(s, newK8sResourceExistsMCPTool(), k8sResourceExistsHandler)
+}
+
+// Tool schema
+func newK8sResourceExistsMCPTool() mcp.Tool {
+	return mcp.NewTool("k8s_resource_exists", readOnlyToolOptions(
+		mcp.WithDescription("Check whether a single Kubernetes resource exists without fetching its body. Returns exists: true/false plus the resourceVersion and uid when it exists. "+
+			"Errors such as RBAC denials are reported as errors rather than as exists: false."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("The name of the resource to check."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to use. Defaults to the context's configured namespace, or 'default' if the context doesn't set one. Ignored for cluster-scoped resources."),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The Kubernetes resource Kind."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func k8sResourceExistsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractK8sResourceExistsParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   params.Group,
+		Version: params.Version,
+		Kind:    params.Kind,
+	}
+
+	// Resolve the resource and its scope
+	mapping, err := k8s.GVKToRESTMapping(params.Context, gvk)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	gvk = mapping.GroupVersionKind
+
+	namespace, err := resolveNamespace(params.Context, mapping, params.Namespace, false)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// A get needs a namespace; use the same fallback as kubectl
+	if namespace == metav1.NamespaceAll && mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		namespace = metav1.NamespaceDefault
+	}
+
+	// Get metadata client
+	metadataClient, err := k8s.GetMetadataClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create metadata client: %v", err)), nil
+	}
+
+	result, err := checkResourceExists(ctx, metadataClient, mapping.Resource, namespace, params.Name)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("get", mapping.Resource, namespace, params.Name, err).Error()), nil
+	}
+	result.Kind = mapping.GroupVersionKind.Kind
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+// checkResourceExists fetches only the resource's metadata, treating NotFound as a result rather
+// than an error. An empty namespace means the resource is cluster-scoped.
+func checkResourceExists(ctx context.Context, metadataClient metadata.Interface, gvr schema.GroupVersionResource, namespace, name string) (*ResourceExistsResult, error) {
+	result := &ResourceExistsResult{
+		Name:      name,
+		Namespace: namespace,
+	}
+
+	object, err := metadataClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.Exists = true
+	result.ResourceVersion = object.ResourceVersion
+	result.UID = string(object.UID)
+	return result, nil
+}
+
+func extractK8sResourceExistsParams(request mcp.CallToolRequest) (*k8sResourceExistsParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := request.RequireString(nameProperty)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%s must not be empty", nameProperty)
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &k8sResourceExistsParams{
+		Context:   context,
+		Name:      name,
+		Namespace: request.GetString(namespaceProperty, ""),
+		Group:     request.GetString(groupProperty, ""),
+		Version:   request.GetString(versionProperty, ""),
+		Kind:      kind,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestCheckResourceExists(t *testing.T) {
+	scheme := metadatafake.NewTestScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	client := metadatafake.NewSimpleMetadataClient(scheme, &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "settings", ResourceVersion: "42", UID: "abc-123"},
+	})
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	t.Run("exists", func(t *testing.T) {
+		got, err := checkResourceExists(context.Background(), client, gvr, "default", "settings")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !got.Exists || got.ResourceVersion != "42" || got.UID != "abc-123" {
+			t.Errorf("unexpected result: %+v", got)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		got, err := checkResourceExists(context.Background(), client, gvr, "default", "other")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Exists || got.ResourceVersion != "" {
+			t.Errorf("expected exists: false, got %+v", got)
+		}
+	})
+
+	t.Run("forbidden is an error", func(t *testing.T) {
+		client.PrependReactor("get", "secrets", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "token", nil)
+		})
+		secrets := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+		if _, err := checkResourceExists(context.Background(), client, secrets, "default", "token"); !apierrors.IsForbidden(err) {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+	})
+}
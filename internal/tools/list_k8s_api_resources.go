@@ -2,48 +2,64 @@ package tools
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"k8s.io/client-go/discovery"
 
 	"github.com/krmcbride/mcp-k8s/internal/k8s"
 )
 
 const (
-	apiResourcesContextProperty = "context"
-	apiResourcesGroupProperty   = "group"
+	namespacedProperty          = "namespaced"
+	includeSubresourcesProperty = "includeSubresources"
 )
 
 type listK8sAPIResourcesParams struct {
-	Context string
-	Group   string
+	Context             string
+	Group               string
+	Namespaced          *bool // nil means no scope filter
+	IncludeSubresources bool
 }
 
 type APIResourceInfo struct {
-	Name       string   `json:"name"`
-	ShortNames []string `json:"shortNames,omitempty"`
-	APIVersion string   `json:"apiVersion"`
-	Namespaced bool     `json:"namespaced"`
-	Kind       string   `json:"kind"`
+	Name        string   `json:"name"`
+	ShortNames  []string `json:"shortNames,omitempty"`
+	APIVersion  string   `json:"apiVersion"`
+	Namespaced  bool     `json:"namespaced"`
+	Kind        string   `json:"kind"`
+	Verbs       []string `json:"verbs,omitempty"`
+	Categories  []string `json:"categories,omitempty"`
+	Subresource bool     `json:"subresource,omitempty"`
 }
 
 func RegisterListK8sAPIResourcesMCPTool(s *server.MCPServer) {
-	s.AddTool(newListK8sAPIResourcesMCPTool(), listK8sAPIResourcesHandler)
+	addTool(s, newListK8sAPIResourcesMCPTool(), listK8sAPIResourcesHandler)
 }
 
 // Tool schema
 func newListK8sAPIResourcesMCPTool() mcp.Tool {
 	return mcp.NewTool("list_k8s_api_resources", readOnlyToolOptions(
-		mcp.WithDescription("List available Kubernetes API resources (equivalent to `kubectl api-resources`)"),
-		mcp.WithString(apiResourcesContextProperty,
+		mcp.WithDescription("List available Kubernetes API resources (equivalent to `kubectl api-resources`). "+
+			"If some API groups fail discovery (e.g. an unavailable aggregated API service), the resources that were discovered are returned as {items, warnings}, "+
+			"with warnings naming the failed group/versions."),
+		mcp.WithString(contextProperty,
 			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
 			mcp.Required(),
 		),
-		mcp.WithString(apiResourcesGroupProperty,
+		mcp.WithString(groupProperty,
 			mcp.Description("Filter by API group. If not specified, returns resources from all groups."),
 		),
+		mcp.WithBoolean(namespacedProperty,
+			mcp.Description("If true, return only namespaced resources; if false, return only cluster-scoped resources. If not specified, returns both."),
+		),
+		mcp.WithBoolean(includeSubresourcesProperty,
+			mcp.Description("Include subresources such as pods/log, pods/status, and deployments/scale. Subresources are marked with subresource: true. Defaults to false."),
+		),
 	)...)
 }
 
@@ -63,11 +79,13 @@ func listK8sAPIResourcesHandler(ctx context.Context, request mcp.CallToolRequest
 
 	// Get all API resources - this can return partial results even with error
 	_, resourceLists, err := discoveryClient.ServerGroupsAndResources()
+	var warnings []string
 	if err != nil {
 		// Continue with partial results if any resource lists were discovered
 		if len(resourceLists) == 0 {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to get API resources: %v", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get API resources: %v", k8s.ClassifyClusterError(err))), nil
 		}
+		warnings = discoveryFailureWarnings(err)
 	}
 
 	var apiResources []APIResourceInfo
@@ -85,36 +103,78 @@ func listK8sAPIResourcesHandler(ctx context.Context, request mcp.CallToolRequest
 
 		// Process each resource in this group/version
 		for _, resource := range resourceList.APIResources {
-			// Skip subresources (those with '/' in the name)
-			if strings.Contains(resource.Name, "/") {
+			// Skip subresources (those with '/' in the name) unless requested
+			isSubresource := strings.Contains(resource.Name, "/")
+			if isSubresource && !params.IncludeSubresources {
+				continue
+			}
+
+			// Skip if scope filter is specified and doesn't match
+			if params.Namespaced != nil && resource.Namespaced != *params.Namespaced {
 				continue
 			}
 
 			apiResource := APIResourceInfo{
-				Name:       resource.Name,
-				ShortNames: resource.ShortNames,
-				APIVersion: resourceList.GroupVersion,
-				Namespaced: resource.Namespaced,
-				Kind:       resource.Kind,
+				Name:        resource.Name,
+				ShortNames:  resource.ShortNames,
+				APIVersion:  resourceList.GroupVersion,
+				Namespaced:  resource.Namespaced,
+				Kind:        resource.Kind,
+				Verbs:       resource.Verbs,
+				Categories:  resource.Categories,
+				Subresource: isSubresource,
 			}
 
 			apiResources = append(apiResources, apiResource)
 		}
 	}
 
+	// Report which group/versions failed discovery alongside the partial results
+	if len(warnings) > 0 {
+		return toJSONToolResult(map[string]any{
+			"items":    apiResources,
+			"warnings": warnings,
+		})
+	}
+
 	// Return as JSON
 	return toJSONToolResult(apiResources)
 }
 
+// discoveryFailureWarnings lists the group/versions that failed discovery, sorted, so broken
+// aggregated API services are reported instead of silently missing from the results
+func discoveryFailureWarnings(err error) []string {
+	var groupErr *discovery.ErrGroupDiscoveryFailed
+	if !errors.As(err, &groupErr) {
+		return []string{fmt.Sprintf("discovery partially failed: %v", err)}
+	}
+
+	warnings := make([]string, 0, len(groupErr.Groups))
+	for gv, gvErr := range groupErr.Groups {
+		warnings = append(warnings, fmt.Sprintf("failed to discover %s: %v", gv.String(), gvErr))
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
 func extractListK8sAPIResourcesParams(request mcp.CallToolRequest) (*listK8sAPIResourcesParams, error) {
-	context, err := request.RequireString(apiResourcesContextProperty)
+	context, err := request.RequireString(contextProperty)
 	if err != nil {
 		return nil, err
 	}
 
+	// Only filter by scope when explicitly requested
+	var namespaced *bool
+	if _, ok := request.GetArguments()[namespacedProperty]; ok {
+		value := request.GetBool(namespacedProperty, false)
+		namespaced = &value
+	}
+
 	return &listK8sAPIResourcesParams{
-		Context: context,
-		Group:   request.GetString(apiResourcesGroupProperty, ""),
+		Context:             context,
+		Group:               request.GetString(groupProperty, ""),
+		Namespaced:          namespaced,
+		IncludeSubresources: request.GetBool(includeSubresourcesProperty, false),
 	}, nil
 }
 
@@ -0,0 +1,34 @@
+package tools
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+func TestDiscoveryFailureWarnings(t *testing.T) {
+	t.Run("group discovery failures", func(t *testing.T) {
+		err := &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{
+			{Group: "metrics.k8s.io", Version: "v1beta1"}:        errors.New("the server is currently unable to handle the request"),
+			{Group: "custom.metrics.k8s.io", Version: "v1beta2"}: errors.New("service unavailable"),
+		}}
+
+		want := []string{
+			"failed to discover custom.metrics.k8s.io/v1beta2: service unavailable",
+			"failed to discover metrics.k8s.io/v1beta1: the server is currently unable to handle the request",
+		}
+		if got := discoveryFailureWarnings(err); !reflect.DeepEqual(got, want) {
+			t.Errorf("discoveryFailureWarnings() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		got := discoveryFailureWarnings(errors.New("boom"))
+		if len(got) != 1 || got[0] != "discovery partially failed: boom" {
+			t.Errorf("unexpected warnings: %v", got)
+		}
+	})
+}
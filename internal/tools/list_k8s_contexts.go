@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+func RegisterListK8sContextsMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sContextsMCPTool(), listK8sContextsHandler)
+}
+
+// Tool schema
+func newListK8sContextsMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_contexts", readOnlyToolOptions(
+		mcp.WithDescription("List the contexts in the current user's kubeconfig with their cluster name, API server URL, and which one is current. "+
+			"Returns the same data as the kubeconfig://contexts resource, for clients that don't support MCP resources. "+
+			"Use it to resolve cluster aliases like 'prod' or 'sandbox' to context names instead of running `kubectl config`."),
+	)...)
+}
+
+// Tool handler
+func listK8sContextsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	contexts, err := k8s.ListContexts()
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list contexts: %v", err)), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(contexts)
+}
@@ -0,0 +1,218 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// maxConcurrentInventoryLists bounds how many resource types are counted at once
+const maxConcurrentInventoryLists = 10
+
+type listK8sNamespaceInventoryParams struct {
+	Context   string
+	Namespace string
+}
+
+// NamespaceInventory summarizes how many objects of each resource type exist in a namespace
+type NamespaceInventory struct {
+	Namespace string                   `json:"namespace"`
+	Total     int                      `json:"total"`
+	Kinds     []NamespaceInventoryKind `json:"kinds"`
+	Skipped   []SkippedInventoryKind   `json:"skipped,omitempty"` // Types that couldn't be listed, e.g. due to RBAC
+}
+
+// NamespaceInventoryKind is the object count for a single resource type
+type NamespaceInventoryKind struct {
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Resource   string `json:"resource"`
+	Count      int    `json:"count"`
+}
+
+// SkippedInventoryKind records a resource type left out of the inventory and why
+type SkippedInventoryKind struct {
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Reason     string `json:"reason"`
+}
+
+// inventoryResource is a listable namespaced resource type found via discovery
+type inventoryResource struct {
+	gvr  schema.GroupVersionResource
+	kind string
+}
+
+func RegisterListK8sNamespaceInventoryMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sNamespaceInventoryMCPTool(), listK8sNamespaceInventoryHandler)
+}
+
+// Tool schema
+func newListK8sNamespaceInventoryMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_namespace_inventory", readOnlyToolOptions(
+		mcp.WithDescription("Summarize what's in a namespace: discovers every listable namespaced resource type and returns object counts per kind, "+
+			"sorted by count. Types with no objects are omitted, and types that can't be listed (e.g. no RBAC permission) are reported under 'skipped'."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to inventory."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func listK8sNamespaceInventoryHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractListK8sNamespaceInventoryParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get discovery client
+	discoveryClient, err := k8s.GetDiscoveryClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create discovery client: %v", err)), nil
+	}
+
+	// Preferred versions only, so a type served at several versions is counted once.
+	// Discovery can return partial results when an aggregated API is unavailable.
+	resourceLists, err := discoveryClient.ServerPreferredNamespacedResources()
+	if err != nil && len(resourceLists) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get API resources: %v", k8s.ClassifyClusterError(err))), nil
+	}
+
+	// Get metadata client
+	metadataClient, err := k8s.GetMetadataClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create metadata client: %v", err)), nil
+	}
+
+	inventory, err := buildNamespaceInventory(ctx, metadataClient, listableNamespacedResources(resourceLists), params.Namespace)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build namespace inventory: %v", err)), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(inventory)
+}
+
+// listableNamespacedResources picks the namespaced, non-subresource types that support list
+func listableNamespacedResources(resourceLists []*metav1.APIResourceList) []inventoryResource {
+	var resources []inventoryResource
+	for _, resourceList := range resourceLists {
+		if resourceList == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			if !resource.Namespaced || strings.Contains(resource.Name, "/") || !slices.Contains(resource.Verbs, "list") {
+				continue
+			}
+			resources = append(resources, inventoryResource{
+				gvr:  gv.WithResource(resource.Name),
+				kind: resource.Kind,
+			})
+		}
+	}
+	return resources
+}
+
+// buildNamespaceInventory counts each resource type in the namespace concurrently. Types that
+// fail to list are recorded as skipped rather than failing the inventory; only cancellation
+// of the request itself is returned as an error.
+func buildNamespaceInventory(ctx context.Context, metadataClient metadata.Interface, resources []inventoryResource, namespace string) (*NamespaceInventory, error) {
+	inventory := &NamespaceInventory{
+		Namespace: namespace,
+		Kinds:     []NamespaceInventoryKind{},
+	}
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentInventoryLists)
+	for _, resource := range resources {
+		g.Go(func() error {
+			counts, err := countResources(gctx, metadataClient, resource.gvr, namespace, metav1.ListOptions{})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				inventory.Skipped = append(inventory.Skipped, SkippedInventoryKind{
+					Kind:       resource.kind,
+					APIVersion: resource.gvr.GroupVersion().String(),
+					Reason:     categorizeK8sError("list", resource.gvr, namespace, "", err).Error(),
+				})
+				return nil
+			}
+			if count := counts[namespace]; count > 0 {
+				inventory.Kinds = append(inventory.Kinds, NamespaceInventoryKind{
+					Kind:       resource.kind,
+					APIVersion: resource.gvr.GroupVersion().String(),
+					Resource:   resource.gvr.Resource,
+					Count:      count,
+				})
+				inventory.Total += count
+			}
+			return nil
+		})
+	}
+	_ = g.Wait() // Per-type errors are collected in inventory.Skipped
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(inventory.Kinds, func(i, j int) bool {
+		a, b := inventory.Kinds[i], inventory.Kinds[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.APIVersion < b.APIVersion
+	})
+	sort.Slice(inventory.Skipped, func(i, j int) bool {
+		a, b := inventory.Skipped[i], inventory.Skipped[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.APIVersion < b.APIVersion
+	})
+
+	return inventory, nil
+}
+
+func extractListK8sNamespaceInventoryParams(request mcp.CallToolRequest) (*listK8sNamespaceInventoryParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("%s must not be empty", namespaceProperty)
+	}
+
+	return &listK8sNamespaceInventoryParams{
+		Context:   context,
+		Namespace: namespace,
+	}, nil
+}
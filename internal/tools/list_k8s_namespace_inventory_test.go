@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestListableNamespacedResources(t *testing.T) {
+	resourceLists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				{Name: "nodes", Kind: "Node", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+	}
+
+	got := listableNamespacedResources(resourceLists)
+	want := []inventoryResource{
+		{gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, kind: "Pod"},
+		{gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, kind: "Deployment"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBuildNamespaceInventory(t *testing.T) {
+	newObject := func(apiVersion, kind, namespace, name string) runtime.Object {
+		return &metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: apiVersion, Kind: kind},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+	}
+	scheme := metadatafake.NewTestScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	client := metadatafake.NewSimpleMetadataClient(scheme,
+		newObject("v1", "Pod", "shop", "web-1"),
+		newObject("v1", "Pod", "shop", "web-2"),
+		newObject("v1", "Pod", "other", "db-1"),
+		newObject("apps/v1", "Deployment", "shop", "web"),
+	)
+	client.PrependReactor("list", "secrets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+	})
+
+	resources := []inventoryResource{
+		{gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, kind: "Pod"},
+		{gvr: schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, kind: "Secret"},
+		{gvr: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, kind: "ConfigMap"},
+		{gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, kind: "Deployment"},
+	}
+
+	got, err := buildNamespaceInventory(context.Background(), client, resources, "shop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantKinds := []NamespaceInventoryKind{
+		{Kind: "Pod", APIVersion: "v1", Resource: "pods", Count: 2},
+		{Kind: "Deployment", APIVersion: "apps/v1", Resource: "deployments", Count: 1},
+	}
+	if !reflect.DeepEqual(got.Kinds, wantKinds) {
+		t.Errorf("expected kinds %v, got %v", wantKinds, got.Kinds)
+	}
+	if got.Total != 3 {
+		t.Errorf("expected total 3, got %d", got.Total)
+	}
+	if len(got.Skipped) != 1 || got.Skipped[0].Kind != "Secret" {
+		t.Errorf("expected Secret to be skipped, got %v", got.Skipped)
+	}
+}
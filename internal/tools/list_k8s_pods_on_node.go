@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+const nodeProperty = "node"
+
+type listK8sPodsOnNodeParams struct {
+	Context       string
+	Node          string
+	LabelSelector string
+}
+
+func RegisterListK8sPodsOnNodeMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sPodsOnNodeMCPTool(), listK8sPodsOnNodeHandler)
+}
+
+// Tool schema
+func newListK8sPodsOnNodeMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_pods_on_node", readOnlyToolOptions(
+		mcp.WithDescription("List all pods scheduled on a node across all namespaces, like kubectl get pods -A --field-selector spec.nodeName=<node>. "+
+			"Use before draining a node or when investigating node-level problems to see what's running there."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(nodeProperty,
+			mcp.Description("The name of the node."),
+			mcp.Required(),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Optional label selector to narrow the pods (e.g. 'app=web')."),
+		),
+	)...)
+}
+
+// Tool handler
+func listK8sPodsOnNodeHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractListK8sPodsOnNodeParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Pods on a node span namespaces, so this is an all-namespaces query
+	if err := CheckNamespaceAccess(metav1.NamespaceAll); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	list, err := listPodsOnNode(ctx, dynamicClient, params.Node, params.LabelSelector)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("list", podGVR, metav1.NamespaceAll, "", err).Error()), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(map[string]any{
+		"node":  params.Node,
+		"count": len(list.Items),
+		"pods":  mapToK8sResourceListContent(list, podGVK),
+	})
+}
+
+// listPodsOnNode lists every pod scheduled on a node across all namespaces, following continue
+// tokens so large nodes aren't silently truncated
+func listPodsOnNode(ctx context.Context, dynamicClient dynamic.Interface, node, labelSelector string) (*unstructured.UnstructuredList, error) {
+	listOptions := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+		LabelSelector: labelSelector,
+		Limit:         countPageSize,
+	}
+
+	pods := &unstructured.UnstructuredList{}
+	for {
+		page, err := dynamicClient.Resource(podGVR).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		pods.Items = append(pods.Items, page.Items...)
+
+		if page.GetContinue() == "" {
+			return pods, nil
+		}
+		listOptions.Continue = page.GetContinue()
+	}
+}
+
+func extractListK8sPodsOnNodeParams(request mcp.CallToolRequest) (*listK8sPodsOnNodeParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := request.RequireString(nodeProperty)
+	if err != nil {
+		return nil, err
+	}
+	if node == "" {
+		return nil, fmt.Errorf("%s must not be empty", nodeProperty)
+	}
+
+	return &listK8sPodsOnNodeParams{
+		Context:       context,
+		Node:          node,
+		LabelSelector: request.GetString(labelSelectorProperty, ""),
+	}, nil
+}
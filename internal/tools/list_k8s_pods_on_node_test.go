@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestListPodsOnNodeFollowsContinue(t *testing.T) {
+	newPod := func(name string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": name, "namespace": "default"},
+		}}
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podGVR: "PodList"})
+
+	var fieldSelectors []string
+	client.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		restrictions := action.(k8stesting.ListAction).GetListRestrictions()
+		fieldSelectors = append(fieldSelectors, restrictions.Fields.String())
+
+		list := &unstructured.UnstructuredList{Object: map[string]any{"apiVersion": "v1", "kind": "PodList"}}
+		if len(fieldSelectors) == 1 {
+			list.Items = []unstructured.Unstructured{newPod("a"), newPod("b")}
+			list.SetContinue("page-2")
+		} else {
+			list.Items = []unstructured.Unstructured{newPod("c")}
+		}
+		return true, list, nil
+	})
+
+	pods, err := listPodsOnNode(context.Background(), client, "node-1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods.Items) != 3 {
+		t.Errorf("expected 3 pods across both pages, got %d", len(pods.Items))
+	}
+	for _, selector := range fieldSelectors {
+		if selector != "spec.nodeName=node-1" {
+			t.Errorf("expected field selector spec.nodeName=node-1, got %q", selector)
+		}
+	}
+}
@@ -3,12 +3,22 @@ package tools
 import (
 	"context"
 	"fmt"
+	"math"
+	"path"
+	"strings"
+	"sync"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/krmcbride/mcp-k8s/internal/k8s"
 )
@@ -20,57 +30,144 @@ const (
 	versionProperty       = "version"
 	kindProperty          = "kind"
 	fieldSelectorProperty = "fieldSelector"
+	labelSelectorProperty = "labelSelector"
+	filterProperty        = "filter"
+	containerProperty     = "container"
 	limitProperty         = "limit"
 	continueProperty      = "continue"
+	allNamespacesProperty = "allNamespaces"
+	minimalProperty       = "minimal"
+	aggregateProperty     = "aggregate"
+	namePrefixProperty    = "namePrefix"
+	rawProperty           = "raw"
 )
 
+// maxConcurrentContexts caps how many clusters are listed at once when fanning out
+const maxConcurrentContexts = 5
+
+// Default page sizes for full and minimal (metadata-only) listings
+const (
+	defaultListLimit        = 100
+	defaultMinimalListLimit = 500
+)
+
+// DefaultMaxListLimit caps the page size a client may request so a single call can't return
+// an enormous list; pagination is the intended way to fetch more
+const DefaultMaxListLimit = 500
+
+// maxListLimit is the largest limit list_k8s_resources accepts; 0 disables the cap
+var maxListLimit = DefaultMaxListLimit
+
+// SetMaxListLimit sets the maximum list page size. A value of 0 or less disables the cap.
+func SetMaxListLimit(n int) {
+	maxListLimit = n
+}
+
 type listK8sResourcesParams struct {
-	Context       string
-	Namespace     string
-	Group         string
-	Version       string
-	Kind          string
-	FieldSelector string
-	Limit         int64
-	Continue      string
+	Contexts             []string
+	Namespace            string
+	AllNamespaces        bool
+	Group                string
+	Version              string
+	Kind                 string
+	FieldSelector        string
+	LabelSelector        string
+	Filter               fields.Selector
+	NamePrefix           string // Name prefix, or a glob pattern if it contains *, ? or [
+	Annotations          []string
+	AnnotationFilter     []annotationRequirement
+	Limit                int64
+	Continue             string
+	Minimal              bool
+	Aggregate            bool
+	Raw                  bool
+	IncludeManagedFields bool
+	LimitWarning         string
+}
+
+// MinimalResourceContent identifies a resource without any of its spec or status, for minimal listings
+type MinimalResourceContent struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
 }
 
 func RegisterListK8sResourcesMCPTool(s *server.MCPServer) {
-	s.AddTool(newListK8sResourcesMCPTool(), listK8sResourcesHandler)
+	addTool(s, newListK8sResourcesMCPTool(), listK8sResourcesHandler)
 }
 
 // Tool schema
 func newListK8sResourcesMCPTool() mcp.Tool {
 	return mcp.NewTool("list_k8s_resources", readOnlyToolOptions(
-		mcp.WithDescription("List Kubernetes resources with optional server-side filtering and pagination"),
+		mcp.WithDescription("List Kubernetes resources with optional server-side field/label filtering and pagination"),
 		mcp.WithString(contextProperty,
-			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource. "+
+				"Pass comma-separated contexts (e.g. 'prod-us,prod-eu') to list across several clusters at once; results are then grouped under 'contexts' by context name with per-context errors."),
 			mcp.Required(),
 		),
 		mcp.WithString(namespaceProperty,
-			mcp.Description("The Kubernetes namespace to use. Defaults to all namespaces."),
+			mcp.Description("The Kubernetes namespace to use. Defaults to the context's configured namespace, or all namespaces if the context doesn't set one. Ignored for cluster-scoped resources."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("List across all namespaces, ignoring the context's default namespace (like kubectl -A). Cannot be used with namespace."),
 		),
 		mcp.WithString(groupProperty,
-			mcp.Description("The Kubernetes resource API Group."),
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
 		),
 		mcp.WithString(versionProperty,
-			mcp.Description("The Kubernetes resource API Version."),
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
 		),
 		mcp.WithString(kindProperty,
 			mcp.Description("The Kubernetes resource Kind."),
 			mcp.Required(),
 		),
 		mcp.WithString(fieldSelectorProperty,
-			mcp.Description("Field selector to filter resources server-side. Examples: 'metadata.namespace!=default', 'status.phase=Running', 'spec.nodeName=node-1'. Multiple selectors can be comma-separated."),
+			mcp.Description("Field selector to filter resources server-side. Examples: 'metadata.namespace!=default', 'status.phase=Running', 'spec.nodeName=node-1'. Multiple selectors can be comma-separated. "+
+				"Only metadata.name and metadata.namespace are supported for every type; other fields are selectable only for specific types (e.g. Pod status.phase and spec.nodeName). Use labelSelector to filter on labels."),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Label selector to filter resources server-side. Examples: 'app=web', 'tier in (frontend,backend)', 'app=web,env!=dev'."),
+		),
+		mcp.WithString(filterProperty,
+			mcp.Description("Client-side filter applied after listing, for fields the server can't select on. Uses field selector syntax with dotted paths into the resource, e.g. 'status.phase==Running', 'spec.nodeName=node-1', 'spec.type!=ClusterIP'. "+
+				"Filtering happens after fetching, so it only applies to the page of results returned by limit/continue."),
+		),
+		mcp.WithString(namePrefixProperty,
+			mcp.Description("Client-side filter keeping only resources whose name starts with this prefix (e.g. 'frontend-'), or matches it as a glob if it contains *, ? or [ (e.g. 'frontend-*-canary'). "+
+				"Filtering happens after fetching, so it only applies to the page of results returned by limit/continue; raise the limit or page through results if matches may be beyond the first page."),
+		),
+		mcp.WithString(annotationsProperty,
+			mcp.Description("Comma-separated annotation keys to include in each item's output under 'annotations', e.g. 'deployment.kubernetes.io/revision,argocd.argoproj.io/tracking-id'. "+
+				"Items without any of the keys are returned unchanged. Not applied to aggregate results; raw items already include all annotations. "+
+				"The kubectl last-applied-configuration annotation is skipped unless includeManagedFields is true."),
+		),
+		mcp.WithString(annotationFilterProperty,
+			mcp.Description("Client-side filter on annotations: comma-separated 'key' (present), '!key' (absent), 'key=value', or 'key!=value' terms, e.g. 'argocd.argoproj.io/tracking-id' or 'example.com/owner=team-a'. "+
+				"Filtering happens after fetching, so it only applies to the page of results returned by limit/continue."),
 		),
 		// NOTE: The Event mapper, which contains a good number of fields, is about 120 tokens per event, so a default
 		// limit of 100 uses about half of the 25k MCP tool response token limit
 		mcp.WithNumber(limitProperty,
-			mcp.Description("Maximum number of resources to return per request. Use for pagination. Must be positive if provided. Defaults to 100."),
+			mcp.Description("Maximum number of resources to return per request. Use for pagination. Must be a positive integer if provided. Defaults to 100, or 500 with minimal. "+
+				"Limits above the server maximum (500 by default) are clamped to it."),
 		),
 		mcp.WithString(continueProperty,
 			mcp.Description("Continue token from previous paginated request. Used to retrieve the next page of results."),
 		),
+		mcp.WithBoolean(minimalProperty,
+			mcp.Description("Return only each resource's name and namespace, fetched as metadata-only objects so the API server never sends spec or status. "+
+				"Use when listing many objects just to find names. The filter parameter can then only match metadata fields."),
+		),
+		mcp.WithBoolean(aggregateProperty,
+			mcp.Description("Only for kind Event. Group events by type, reason, and involved object, summing their counts and reporting first/last seen and the latest message, like the deduplicated kubectl get events view. "+
+				"Aggregation covers the page of events returned by limit/continue."),
+		),
+		mcp.WithBoolean(rawProperty,
+			mcp.Description("Return the full unstructured objects instead of the condensed mapped content, for fields the mapper doesn't surface. "+
+				"Raw items are much larger, so use a small limit. Cannot be used with minimal or aggregate."),
+		),
+		mcp.WithBoolean(includeManagedFieldsProp,
+			mcp.Description("Keep metadata.managedFields and the kubectl last-applied-configuration annotation in raw items, and allow the annotation in annotations output. They are stripped by default since they are large and rarely useful."),
+		),
 	)...)
 }
 
@@ -82,6 +179,50 @@ func listK8sResourcesHandler(ctx context.Context, request mcp.CallToolRequest) (
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	// Fan out across clusters if multiple contexts were given
+	if len(params.Contexts) > 1 {
+		return toJSONToolResult(map[string]any{
+			"contexts": listK8sResourcesAcrossContexts(ctx, params),
+		})
+	}
+
+	response, err := listK8sResources(ctx, params.Contexts[0], params)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(response)
+}
+
+// listK8sResourcesAcrossContexts lists resources in each context concurrently, returning results
+// keyed by context with per-context errors instead of failing the whole request
+func listK8sResourcesAcrossContexts(ctx context.Context, params *listK8sResourcesParams) map[string]any {
+	results := make(map[string]any, len(params.Contexts))
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentContexts)
+	for _, k8sContext := range params.Contexts {
+		g.Go(func() error {
+			response, err := listK8sResources(gctx, k8sContext, params)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				results[k8sContext] = map[string]any{"error": err.Error()}
+				return nil
+			}
+			results[k8sContext] = response
+			return nil
+		})
+	}
+	_ = g.Wait() // Per-context errors are recorded in results
+
+	return results
+}
+
+// listK8sResources lists one page of resources in a single context and builds the response
+// with pagination metadata
+func listK8sResources(ctx context.Context, k8sContext string, params *listK8sResourcesParams) (map[string]any, error) {
 	// Create GVK
 	gvk := schema.GroupVersionKind{
 		Group:   params.Group,
@@ -90,44 +231,78 @@ func listK8sResourcesHandler(ctx context.Context, request mcp.CallToolRequest) (
 	}
 
 	// Convert GVK to GVR
-	gvr, err := k8s.GVKToGVR(params.Context, gvk)
+	mapping, err := k8s.GVKToRESTMapping(k8sContext, gvk)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, err
 	}
+	gvk, gvr := mapping.GroupVersionKind, mapping.Resource
 
-	// Get dynamic client
-	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	namespace, err := resolveNamespace(k8sContext, mapping, params.Namespace, params.AllNamespaces)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+		return nil, err
 	}
 
 	// Prepare list options with field selector and pagination
 	listOptions := metav1.ListOptions{
-		Limit: params.Limit, // Always set limit (defaults to 100)
+		Limit: params.Limit, // Always set limit (defaults to 100, or 500 when minimal)
 	}
 	if params.FieldSelector != "" {
 		listOptions.FieldSelector = params.FieldSelector
 	}
+	if params.LabelSelector != "" {
+		listOptions.LabelSelector = params.LabelSelector
+	}
 	if params.Continue != "" {
 		listOptions.Continue = params.Continue
 	}
 
 	// List resources
 	var list *unstructured.UnstructuredList
-	if params.Namespace == metav1.NamespaceAll {
-		list, err = dynamicClient.Resource(gvr).List(ctx, listOptions)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to list resources: %v", err)), nil
-		}
+	if params.Minimal {
+		list, err = listResourceMetadata(ctx, k8sContext, gvr, namespace, listOptions)
 	} else {
-		list, err = dynamicClient.Resource(gvr).Namespace(params.Namespace).List(ctx, listOptions)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to list resources: %v", err)), nil
+		list, err = listUnstructuredResources(ctx, k8sContext, gvr, namespace, listOptions)
+	}
+	if err != nil {
+		if params.FieldSelector != "" && apierrors.IsBadRequest(err) {
+			return nil, fmt.Errorf("failed to list resources: %w. %s", err, unsupportedFieldSelectorHint)
 		}
+		return nil, categorizeK8sError("list", gvr, namespace, "", err)
+	}
+
+	// Apply client-side filter to the fetched page
+	fetched := len(list.Items)
+	if params.Filter != nil {
+		list.Items = filterUnstructuredItems(list.Items, params.Filter)
+	}
+	if params.NamePrefix != "" {
+		list.Items = filterItemsByName(list.Items, params.NamePrefix)
+	}
+	if len(params.AnnotationFilter) > 0 {
+		list.Items = filterItemsByAnnotations(list.Items, params.AnnotationFilter)
 	}
 
 	// Map to appropriate content structure
-	items := mapToK8sResourceListContent(list, gvk)
+	var items []any
+	switch {
+	case params.Aggregate:
+		aggregated := aggregateEvents(list.Items)
+		items = make([]any, 0, len(aggregated))
+		for _, event := range aggregated {
+			items = append(items, event)
+		}
+	case params.Minimal:
+		items = mapToMinimalResourceListContent(list)
+	case params.Raw:
+		items = mapToRawResourceListContent(list, params.IncludeManagedFields)
+	default:
+		items = mapToK8sResourceListContent(list, gvk)
+	}
+
+	// Surface requested annotations that no mapper includes
+	if len(params.Annotations) > 0 && !params.Aggregate && !params.Raw {
+		items = addRequestedAnnotations(items, list.Items, params.Annotations, params.IncludeManagedFields)
+	}
 
 	// Create response with pagination metadata
 	response := map[string]any{
@@ -150,12 +325,108 @@ func listK8sResourcesHandler(ctx context.Context, request mcp.CallToolRequest) (
 		hasMetadata = true
 	}
 
+	// Report how many fetched items the client-side filters excluded
+	if params.Filter != nil || params.NamePrefix != "" || len(params.AnnotationFilter) > 0 {
+		metadata["filteredOut"] = fetched - len(list.Items)
+		hasMetadata = true
+	}
+
+	// Report how many Event objects were folded into the aggregated entries
+	if params.Aggregate {
+		metadata["aggregatedEvents"] = len(list.Items)
+		hasMetadata = true
+	}
+
+	// Explain why fewer items than requested may have been returned
+	if params.LimitWarning != "" {
+		metadata["warning"] = params.LimitWarning
+		hasMetadata = true
+	}
+
+	// Flag truncated results so callers don't mistake a single page for the full set
+	if _, hasContinue := metadata["continue"]; hasContinue && params.Limit > 0 && int64(fetched) >= params.Limit {
+		metadata["truncated"] = true
+		metadata["note"] = fmt.Sprintf("Results were truncated at the limit of %d. Pass the continue token to retrieve the next page.", params.Limit)
+	}
+
 	if hasMetadata {
 		response["metadata"] = metadata
 	}
 
-	// Return as JSON
-	return toJSONToolResult(response)
+	return response, nil
+}
+
+// listUnstructuredResources lists full objects with the dynamic client
+func listUnstructuredResources(ctx context.Context, k8sContext string, gvr schema.GroupVersionResource, namespace string, listOptions metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	dynamicClient, err := k8s.GetDynamicClientForContext(k8sContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	if namespace == metav1.NamespaceAll {
+		return dynamicClient.Resource(gvr).List(ctx, listOptions)
+	}
+	return dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, listOptions)
+}
+
+// listResourceMetadata lists PartialObjectMetadata with the metadata client, so the API server
+// only sends object metadata, and converts the result to an unstructured list so pagination and
+// client-side filtering work the same as for full objects
+func listResourceMetadata(ctx context.Context, k8sContext string, gvr schema.GroupVersionResource, namespace string, listOptions metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	metadataClient, err := k8s.GetMetadataClientForContext(k8sContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+
+	metadataList, err := metadataClient.Resource(gvr).Namespace(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return partialObjectMetadataListToUnstructured(metadataList)
+}
+
+func partialObjectMetadataListToUnstructured(metadataList *metav1.PartialObjectMetadataList) (*unstructured.UnstructuredList, error) {
+	listObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(metadataList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert metadata list: %w", err)
+	}
+	delete(listObject, "items")
+
+	list := &unstructured.UnstructuredList{
+		Object: listObject,
+		Items:  make([]unstructured.Unstructured, 0, len(metadataList.Items)),
+	}
+	for i := range metadataList.Items {
+		item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&metadataList.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert metadata for %s: %w", metadataList.Items[i].Name, err)
+		}
+		list.Items = append(list.Items, unstructured.Unstructured{Object: item})
+	}
+	return list, nil
+}
+
+func mapToMinimalResourceListContent(list *unstructured.UnstructuredList) []any {
+	content := make([]any, 0, len(list.Items))
+	for _, item := range list.Items {
+		content = append(content, MinimalResourceContent{
+			Name:      item.GetName(),
+			Namespace: item.GetNamespace(),
+		})
+	}
+	return content
+}
+
+// mapToRawResourceListContent returns the full objects, stripping noisy metadata unless asked to keep it
+func mapToRawResourceListContent(list *unstructured.UnstructuredList, includeManagedFields bool) []any {
+	content := make([]any, 0, len(list.Items))
+	for i := range list.Items {
+		if !includeManagedFields {
+			StripNoisyMetadata(&list.Items[i])
+		}
+		content = append(content, list.Items[i].Object)
+	}
+	return content
 }
 
 func extractListK8sResourcesParams(request mcp.CallToolRequest) (*listK8sResourcesParams, error) {
@@ -164,25 +435,245 @@ func extractListK8sResourcesParams(request mcp.CallToolRequest) (*listK8sResourc
 		return nil, err
 	}
 
+	// Split comma-separated contexts for multi-cluster fan-out
+	contexts := splitCommaSeparated(context)
+	if len(contexts) == 0 {
+		return nil, fmt.Errorf("%s must not be empty", contextProperty)
+	}
+
+	continueToken := request.GetString(continueProperty, "")
+	if len(contexts) > 1 && continueToken != "" {
+		return nil, fmt.Errorf("'%s' cannot be used with multiple contexts since continue tokens are specific to one cluster", continueProperty)
+	}
+
 	kind, err := request.RequireString(kindProperty)
 	if err != nil {
 		return nil, err
 	}
 
-	// Extract and validate limit (default to 100)
-	limit := request.GetFloat(limitProperty, 100)
-	if limit < 0 {
-		return nil, fmt.Errorf("limit must be positive, got %v", limit)
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	minimal := request.GetBool(minimalProperty, false)
+	aggregate := request.GetBool(aggregateProperty, false)
+	if aggregate && !strings.EqualFold(kind, "Event") {
+		return nil, fmt.Errorf("'%s' is only supported for kind Event, got %s", aggregateProperty, kind)
+	}
+	if aggregate && minimal {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", aggregateProperty, minimalProperty)
+	}
+	raw := request.GetBool(rawProperty, false)
+	if raw && (minimal || aggregate) {
+		return nil, fmt.Errorf("'%s' cannot be combined with '%s' or '%s'", rawProperty, minimalProperty, aggregateProperty)
+	}
+
+	// Extract and validate limit, defaulting higher for minimal listings since each item is tiny
+	defaultLimit := defaultListLimit
+	if minimal {
+		defaultLimit = defaultMinimalListLimit
+	}
+	limit, err := extractLimit(request, defaultLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clamp to the server maximum; a limit of 0 would otherwise return everything
+	var limitWarning string
+	if maxListLimit > 0 && (limit == 0 || limit > int64(maxListLimit)) {
+		limitWarning = fmt.Sprintf("Requested limit %d exceeds the server maximum; clamped to %d. Use the continue token to page through more results.", limit, maxListLimit)
+		if limit == 0 {
+			limitWarning = fmt.Sprintf("Unlimited listing is not allowed; clamped to the server maximum of %d. Use the continue token to page through more results.", maxListLimit)
+		}
+		limit = int64(maxListLimit)
+	}
+
+	fieldSelector, err := normalizeFieldSelector(request.GetString(fieldSelectorProperty, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	var filter fields.Selector
+	if filterExpr := request.GetString(filterProperty, ""); filterExpr != "" {
+		filter, err = fields.ParseSelector(filterExpr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter '%s': %w. Expected comma-separated 'path=value', 'path==value', or 'path!=value' terms", filterExpr, err)
+		}
+	}
+
+	namePrefix := request.GetString(namePrefixProperty, "")
+	if isGlobPattern(namePrefix) {
+		if _, err := path.Match(namePrefix, ""); err != nil {
+			return nil, fmt.Errorf("invalid %s glob '%s': %w", namePrefixProperty, namePrefix, err)
+		}
+	}
+
+	annotationFilter, err := parseAnnotationFilter(request.GetString(annotationFilterProperty, ""))
+	if err != nil {
+		return nil, err
 	}
 
 	return &listK8sResourcesParams{
-		Context:       context,
-		Namespace:     request.GetString(namespaceProperty, metav1.NamespaceAll),
-		Group:         request.GetString(groupProperty, ""),
-		Version:       request.GetString(versionProperty, "v1"),
-		Kind:          kind,
-		FieldSelector: request.GetString(fieldSelectorProperty, ""),
-		Limit:         int64(limit),
-		Continue:      request.GetString(continueProperty, ""),
+		Contexts:             contexts,
+		Namespace:            namespace,
+		AllNamespaces:        allNamespaces,
+		Group:                request.GetString(groupProperty, ""),
+		Version:              request.GetString(versionProperty, ""),
+		Kind:                 kind,
+		FieldSelector:        fieldSelector,
+		LabelSelector:        request.GetString(labelSelectorProperty, ""),
+		Filter:               filter,
+		NamePrefix:           namePrefix,
+		Annotations:          splitCommaSeparated(request.GetString(annotationsProperty, "")),
+		AnnotationFilter:     annotationFilter,
+		Limit:                limit,
+		Continue:             continueToken,
+		Minimal:              minimal,
+		Aggregate:            aggregate,
+		Raw:                  raw,
+		IncludeManagedFields: request.GetBool(includeManagedFieldsProp, false),
+		LimitWarning:         limitWarning,
 	}, nil
 }
+
+// extractLimit reads the limit parameter, rejecting values that aren't non-negative integers
+// instead of silently truncating them
+func extractLimit(request mcp.CallToolRequest, defaultLimit int) (int64, error) {
+	if _, found := request.GetArguments()[limitProperty]; !found {
+		return int64(defaultLimit), nil
+	}
+
+	limit, err := request.RequireFloat(limitProperty)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(limit) || math.IsInf(limit, 0) || limit != math.Trunc(limit) {
+		return 0, fmt.Errorf("limit must be an integer, got %v", limit)
+	}
+	if limit < 0 {
+		return 0, fmt.Errorf("limit must be positive, got %v", limit)
+	}
+	return int64(limit), nil
+}
+
+// unsupportedFieldSelectorHint explains the server-side field selector limits when the API rejects a selector
+const unsupportedFieldSelectorHint = "Only metadata.name and metadata.namespace are selectable for every resource type; " +
+	"other fields are supported only for specific types (e.g. Pod status.phase, spec.nodeName). " +
+	"Use labelSelector to filter on labels."
+
+// normalizeFieldSelector parses a field selector client-side so syntax errors and common mistakes
+// are reported clearly instead of as raw API server errors. Whitespace around fields and values
+// is trimmed, empty terms are dropped, '==' becomes '=', and values are re-escaped, so the server
+// always receives a well-formed selector.
+func normalizeFieldSelector(selector string) (string, error) {
+	if strings.TrimSpace(selector) == "" {
+		return "", nil
+	}
+
+	parsed, err := fields.ParseSelector(selector)
+	if err != nil {
+		return "", fmt.Errorf("invalid field selector '%s': %w. Expected comma-separated 'field=value', 'field==value', or 'field!=value' terms", selector, err)
+	}
+
+	var terms []fields.Selector
+	for _, requirement := range parsed.Requirements() {
+		field := strings.TrimSpace(requirement.Field)
+		value := strings.TrimSpace(requirement.Value)
+		if field == "" {
+			return "", fmt.Errorf("invalid field selector '%s': every term needs a field name before the operator", selector)
+		}
+		if strings.ContainsAny(field, " \t") {
+			return "", fmt.Errorf("invalid field selector '%s': field '%s' contains whitespace", selector, field)
+		}
+		if strings.HasPrefix(field, "metadata.labels") || strings.HasPrefix(field, "metadata.annotations") {
+			return "", fmt.Errorf("invalid field selector '%s': field '%s' is not selectable server-side. Labels and annotations cannot be used in field selectors; use labelSelector to filter on labels", selector, field)
+		}
+
+		switch requirement.Operator {
+		case selection.NotEquals:
+			terms = append(terms, fields.OneTermNotEqualSelector(field, value))
+		default:
+			terms = append(terms, fields.OneTermEqualSelector(field, value))
+		}
+	}
+
+	return fields.AndSelectors(terms...).String(), nil
+}
+
+// filterUnstructuredItems returns the items whose fields satisfy every requirement of the filter.
+// Requirement fields are dotted paths into the object (e.g. status.phase); a missing field
+// compares as an empty string.
+func filterUnstructuredItems(items []unstructured.Unstructured, filter fields.Selector) []unstructured.Unstructured {
+	var filtered []unstructured.Unstructured
+	for _, item := range items {
+		if filter.Matches(unstructuredFieldSet{object: item.Object}) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// filterItemsByName returns the items whose name starts with pattern, or matches it when it's a glob
+func filterItemsByName(items []unstructured.Unstructured, pattern string) []unstructured.Unstructured {
+	glob := isGlobPattern(pattern)
+	var filtered []unstructured.Unstructured
+	for _, item := range items {
+		name := item.GetName()
+		if glob {
+			// The pattern was validated when extracting parameters
+			if matched, _ := path.Match(pattern, name); matched {
+				filtered = append(filtered, item)
+			}
+		} else if strings.HasPrefix(name, pattern) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// isGlobPattern reports whether a name filter uses glob syntax rather than being a plain prefix
+func isGlobPattern(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// unstructuredFieldSet adapts an unstructured object to fields.Fields using dotted paths
+type unstructuredFieldSet struct {
+	object map[string]any
+}
+
+func (f unstructuredFieldSet) Has(field string) bool {
+	_, found, _ := unstructured.NestedFieldNoCopy(f.object, strings.Split(field, ".")...)
+	return found
+}
+
+func (f unstructuredFieldSet) Get(field string) string {
+	value, found, _ := unstructured.NestedFieldNoCopy(f.object, strings.Split(field, ".")...)
+	if !found || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// resolveNamespace determines the namespace to query. Cluster-scoped resources ignore the
+// namespace; namespaced resources fall back to the context's default namespace when none was
+// given and all namespaces weren't requested, like kubectl. The resolved namespace is checked
+// against the namespace policy.
+func resolveNamespace(k8sContext string, mapping *meta.RESTMapping, namespace string, allNamespaces bool) (string, error) {
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return metav1.NamespaceAll, nil
+	}
+	if namespace == "" && !allNamespaces {
+		var err error
+		namespace, err = k8s.GetContextNamespace(k8sContext)
+		if err != nil {
+			return "", fmt.Errorf("failed to read context namespace: %w", err)
+		}
+	}
+
+	if err := CheckNamespaceAccess(namespace); err != nil {
+		return "", err
+	}
+	return namespace, nil
+}
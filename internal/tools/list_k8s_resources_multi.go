@@ -0,0 +1,246 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/sync/errgroup"
+)
+
+const kindsProperty = "kinds"
+
+const (
+	// maxMultiKinds caps how many kinds a single list_k8s_resources_multi call may request
+	maxMultiKinds = 10
+	// maxConcurrentKinds caps how many kinds are listed at once
+	maxConcurrentKinds = 5
+	// defaultMultiListLimit is the per-kind page size, lower than list_k8s_resources since
+	// several kinds share one response
+	defaultMultiListLimit = 50
+)
+
+type listK8sResourcesMultiParams struct {
+	Base  listK8sResourcesParams // Shared context, namespace, selector and limit settings
+	Kinds []multiListKind
+}
+
+// multiListKind is one kind requested from list_k8s_resources_multi
+type multiListKind struct {
+	Label   string // Key for this kind's results, e.g. "Pod" or "Deployment.apps"
+	Group   string
+	Version string
+	Kind    string
+}
+
+func RegisterListK8sResourcesMultiMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sResourcesMultiMCPTool(), listK8sResourcesMultiHandler)
+}
+
+// Tool schema
+func newListK8sResourcesMultiMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_resources_multi", readOnlyToolOptions(
+		mcp.WithDescription("List several resource kinds in one context and namespace at once, e.g. Pods, Deployments, and Events when triaging a namespace. "+
+			"The kinds are listed concurrently and results are grouped by kind, each shaped like a list_k8s_resources response, with per-kind errors. "+
+			"Use list_k8s_resources with the continue token to page through more results for a kind."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithArray(kindsProperty,
+			mcp.Description(fmt.Sprintf("The kinds to list, e.g. [{\"kind\": \"Pod\"}, {\"kind\": \"Deployment\", \"group\": \"apps\"}, {\"kind\": \"Event\"}]. "+
+				"group and version are optional and resolved like list_k8s_resources. Results are keyed by kind, or kind.group when a group is given. At most %d kinds.", maxMultiKinds)),
+			mcp.Required(),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					kindProperty:    map[string]any{"type": "string", "description": "The Kubernetes resource Kind."},
+					groupProperty:   map[string]any{"type": "string", "description": "The API group. Optional."},
+					versionProperty: map[string]any{"type": "string", "description": "The API version. Optional."},
+				},
+				"required": []string{kindProperty},
+			}),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to use. Defaults to the context's configured namespace, or all namespaces if the context doesn't set one. Ignored for cluster-scoped kinds."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("List across all namespaces, ignoring the context's default namespace (like kubectl -A). Cannot be used with namespace."),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Label selector applied to every kind, e.g. 'app=web'. Note that Events rarely carry labels, so a selector usually leaves them empty."),
+		),
+		mcp.WithString(annotationsProperty,
+			mcp.Description("Comma-separated annotation keys to include in each item's output under 'annotations', e.g. 'deployment.kubernetes.io/revision'. Not applied to aggregated Events."),
+		),
+		mcp.WithString(annotationFilterProperty,
+			mcp.Description("Client-side filter on annotations applied to every kind: comma-separated 'key' (present), '!key' (absent), 'key=value', or 'key!=value' terms."),
+		),
+		mcp.WithNumber(limitProperty,
+			mcp.Description(fmt.Sprintf("Maximum number of resources to return per kind. Defaults to %d, or %d with minimal.", defaultMultiListLimit, defaultMinimalListLimit)),
+		),
+		mcp.WithBoolean(minimalProperty,
+			mcp.Description("Return only each resource's name and namespace, fetched as metadata-only objects. Use when only names are needed."),
+		),
+		mcp.WithBoolean(aggregateProperty,
+			mcp.Description("Aggregate Events by type, reason, and involved object like list_k8s_resources does. Only applies to kind Event; other kinds are listed normally. Cannot be used with minimal."),
+		),
+	)...)
+}
+
+// Tool handler
+func listK8sResourcesMultiHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractListK8sResourcesMultiParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(map[string]any{
+		"kinds": listK8sResourcesForKinds(ctx, params),
+	})
+}
+
+// listK8sResourcesForKinds lists each kind concurrently, returning results keyed by kind label
+// with per-kind errors instead of failing the whole request
+func listK8sResourcesForKinds(ctx context.Context, params *listK8sResourcesMultiParams) map[string]any {
+	k8sContext := params.Base.Contexts[0]
+	results := make(map[string]any, len(params.Kinds))
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentKinds)
+	for _, kind := range params.Kinds {
+		g.Go(func() error {
+			kindParams := params.Base
+			kindParams.Group, kindParams.Version, kindParams.Kind = kind.Group, kind.Version, kind.Kind
+			kindParams.Aggregate = params.Base.Aggregate && strings.EqualFold(kind.Kind, "Event")
+
+			response, err := listK8sResources(gctx, k8sContext, &kindParams)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				results[kind.Label] = map[string]any{"error": err.Error()}
+				return nil
+			}
+			results[kind.Label] = response
+			return nil
+		})
+	}
+	_ = g.Wait() // Per-kind errors are recorded in results
+
+	return results
+}
+
+func extractListK8sResourcesMultiParams(request mcp.CallToolRequest) (*listK8sResourcesMultiParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds, err := extractMultiListKinds(request)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	minimal := request.GetBool(minimalProperty, false)
+	aggregate := request.GetBool(aggregateProperty, false)
+	if aggregate && minimal {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", aggregateProperty, minimalProperty)
+	}
+
+	defaultLimit := defaultMultiListLimit
+	if minimal {
+		defaultLimit = defaultMinimalListLimit
+	}
+	limit, err := extractLimit(request, defaultLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clamp to the server maximum like list_k8s_resources; a limit of 0 would otherwise return everything.
+	// Only an explicit limit above the maximum is worth a warning.
+	var limitWarning string
+	if maxListLimit > 0 && (limit == 0 || limit > int64(maxListLimit)) {
+		if limit > 0 {
+			limitWarning = fmt.Sprintf("Requested limit %d exceeds the server maximum; clamped to %d. Use list_k8s_resources with the continue token to page through more results.", limit, maxListLimit)
+		}
+		limit = int64(maxListLimit)
+	}
+
+	annotationFilter, err := parseAnnotationFilter(request.GetString(annotationFilterProperty, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	return &listK8sResourcesMultiParams{
+		Base: listK8sResourcesParams{
+			Contexts:         []string{context},
+			Namespace:        namespace,
+			AllNamespaces:    allNamespaces,
+			LabelSelector:    request.GetString(labelSelectorProperty, ""),
+			Annotations:      splitCommaSeparated(request.GetString(annotationsProperty, "")),
+			AnnotationFilter: annotationFilter,
+			Limit:            limit,
+			Minimal:          minimal,
+			Aggregate:        aggregate,
+			LimitWarning:     limitWarning,
+		},
+		Kinds: kinds,
+	}, nil
+}
+
+// extractMultiListKinds validates the kinds array, rejecting duplicates so results can be keyed by kind
+func extractMultiListKinds(request mcp.CallToolRequest) ([]multiListKind, error) {
+	raw, found := request.GetArguments()[kindsProperty]
+	if !found {
+		return nil, fmt.Errorf("required argument %q not found", kindsProperty)
+	}
+	entries, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of objects like {\"kind\": \"Pod\"}", kindsProperty)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%s must not be empty", kindsProperty)
+	}
+	if len(entries) > maxMultiKinds {
+		return nil, fmt.Errorf("at most %d %s may be listed at once, got %d", maxMultiKinds, kindsProperty, len(entries))
+	}
+
+	kinds := make([]multiListKind, 0, len(entries))
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		entryMap, ok := entry.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be an object like {\"kind\": \"Pod\"}", kindsProperty, i)
+		}
+
+		kind, _ := entryMap[kindProperty].(string)
+		if kind == "" {
+			return nil, fmt.Errorf("%s[%d] is missing '%s'", kindsProperty, i, kindProperty)
+		}
+		group, _ := entryMap[groupProperty].(string)
+		version, _ := entryMap[versionProperty].(string)
+
+		label := kind
+		if group != "" {
+			label = kind + "." + group
+		}
+		if seen[label] {
+			return nil, fmt.Errorf("%s lists %s more than once", kindsProperty, label)
+		}
+		seen[label] = true
+
+		kinds = append(kinds, multiListKind{Label: label, Group: group, Version: version, Kind: kind})
+	}
+	return kinds, nil
+}
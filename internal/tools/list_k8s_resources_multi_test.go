@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestExtractListK8sResourcesMultiParams(t *testing.T) {
+	newRequest := func(kinds any) mcp.CallToolRequest {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{
+			"context":   "prod",
+			"namespace": "team-a",
+			"kinds":     kinds,
+		}
+		return request
+	}
+
+	t.Run("kinds with optional group and version", func(t *testing.T) {
+		params, err := extractListK8sResourcesMultiParams(newRequest([]any{
+			map[string]any{"kind": "Pod"},
+			map[string]any{"kind": "Deployment", "group": "apps", "version": "v1"},
+			map[string]any{"kind": "Event"},
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []multiListKind{
+			{Label: "Pod", Kind: "Pod"},
+			{Label: "Deployment.apps", Group: "apps", Version: "v1", Kind: "Deployment"},
+			{Label: "Event", Kind: "Event"},
+		}
+		if !reflect.DeepEqual(params.Kinds, want) {
+			t.Errorf("kinds = %+v, want %+v", params.Kinds, want)
+		}
+		if params.Base.Namespace != "team-a" || params.Base.Limit != defaultMultiListLimit {
+			t.Errorf("unexpected base params: %+v", params.Base)
+		}
+	})
+
+	invalid := []struct {
+		name  string
+		kinds any
+	}{
+		{name: "not an array", kinds: "Pod,Deployment"},
+		{name: "empty", kinds: []any{}},
+		{name: "missing kind", kinds: []any{map[string]any{"group": "apps"}}},
+		{name: "string entry", kinds: []any{"Pod"}},
+		{name: "duplicate", kinds: []any{map[string]any{"kind": "Pod"}, map[string]any{"kind": "Pod", "version": "v1"}}},
+		{name: "too many", kinds: func() []any {
+			var kinds []any
+			for i := range maxMultiKinds + 1 {
+				kinds = append(kinds, map[string]any{"kind": fmt.Sprintf("Kind%d", i)})
+			}
+			return kinds
+		}()},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := extractListK8sResourcesMultiParams(newRequest(tt.kinds)); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestExtractListK8sResourcesMultiParamsLimitClamp(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       float64
+		wantWarning bool
+	}{
+		{name: "unlimited is clamped silently", limit: 0},
+		{name: "above maximum warns", limit: float64(DefaultMaxListLimit + 1), wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{
+				"context":   "prod",
+				"namespace": "team-a",
+				"kinds":     []any{map[string]any{"kind": "Pod"}},
+				"limit":     tt.limit,
+			}
+
+			params, err := extractListK8sResourcesMultiParams(request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if params.Base.Limit != int64(maxListLimit) {
+				t.Errorf("expected limit clamped to %d, got %d", maxListLimit, params.Base.Limit)
+			}
+			if gotWarning := params.Base.LimitWarning != ""; gotWarning != tt.wantWarning {
+				t.Errorf("expected warning %v, got %q", tt.wantWarning, params.Base.LimitWarning)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package tools
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+func TestNormalizeFieldSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     string
+		wantErr  string
+	}{
+		{name: "empty", selector: ""},
+		{name: "single term", selector: "status.phase=Running", want: "status.phase=Running"},
+		{name: "multiple terms", selector: "status.phase!=Succeeded,spec.nodeName==node-1", want: "spec.nodeName=node-1,status.phase!=Succeeded"},
+		{name: "whitespace around terms", selector: " status.phase != Running , spec.nodeName=node-1 ", want: "spec.nodeName=node-1,status.phase!=Running"},
+		{name: "empty terms dropped", selector: "status.phase=Running,,", want: "status.phase=Running"},
+		{name: "escaped comma in value", selector: `metadata.name=a\,b`, want: `metadata.name=a\,b`},
+		{name: "invalid syntax", selector: "status.phase", wantErr: "invalid field selector"},
+		{name: "missing field", selector: "=Running", wantErr: "needs a field name"},
+		{name: "whitespace in field", selector: "status phase=Running", wantErr: "contains whitespace"},
+		{name: "labels field", selector: "metadata.labels.app=web", wantErr: "use labelSelector"},
+		{name: "annotations field", selector: "metadata.annotations.owner=team", wantErr: "not selectable server-side"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeFieldSelector(tt.selector)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("expected %q, got %q", tt.want, got)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %q", tt.wantErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestFilterUnstructuredItems(t *testing.T) {
+	newPod := func(name, phase, nodeName string, restartCount int64) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": name},
+			"spec":     map[string]any{"nodeName": nodeName},
+			"status":   map[string]any{"phase": phase, "restarts": restartCount},
+		}}
+	}
+	items := []unstructured.Unstructured{
+		newPod("a", "Running", "node-1", 0),
+		newPod("b", "Pending", "", 0),
+		newPod("c", "Running", "node-2", 3),
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{name: "equals", filter: "status.phase==Running", want: []string{"a", "c"}},
+		{name: "single equals", filter: "spec.nodeName=node-1", want: []string{"a"}},
+		{name: "not equals", filter: "status.phase!=Running", want: []string{"b"}},
+		{name: "multiple terms", filter: "status.phase=Running,spec.nodeName!=node-1", want: []string{"c"}},
+		{name: "numeric value", filter: "status.restarts=3", want: []string{"c"}},
+		{name: "missing field compares as empty", filter: "spec.missing!=x", want: []string{"a", "b", "c"}},
+		{name: "no matches", filter: "status.phase=Failed", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := fields.ParseSelector(tt.filter)
+			if err != nil {
+				t.Fatalf("failed to parse filter: %v", err)
+			}
+
+			var got []string
+			for _, item := range filterUnstructuredItems(items, selector) {
+				got = append(got, item.GetName())
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterItemsByName(t *testing.T) {
+	var items []unstructured.Unstructured
+	for _, name := range []string{"frontend-7d9f-abc", "frontend-canary-xyz", "backend-5c8d-def"} {
+		item := unstructured.Unstructured{}
+		item.SetName(name)
+		items = append(items, item)
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{name: "prefix", pattern: "frontend-", want: []string{"frontend-7d9f-abc", "frontend-canary-xyz"}},
+		{name: "glob", pattern: "*-canary-*", want: []string{"frontend-canary-xyz"}},
+		{name: "glob must match whole name", pattern: "backend-*-de", want: nil},
+		{name: "no matches", pattern: "worker-", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, item := range filterItemsByName(items, tt.pattern) {
+				got = append(got, item.GetName())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExtractListK8sResourcesParamsContexts(t *testing.T) {
+	newRequest := func(args map[string]any) mcp.CallToolRequest {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		return request
+	}
+
+	t.Run("comma-separated contexts", func(t *testing.T) {
+		params, err := extractListK8sResourcesParams(newRequest(map[string]any{
+			"context": "prod-us, prod-eu,,",
+			"kind":    "Pod",
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Join(params.Contexts, ",") != "prod-us,prod-eu" {
+			t.Errorf("expected [prod-us prod-eu], got %v", params.Contexts)
+		}
+	})
+
+	t.Run("continue rejected with multiple contexts", func(t *testing.T) {
+		_, err := extractListK8sResourcesParams(newRequest(map[string]any{
+			"context":  "prod-us,prod-eu",
+			"kind":     "Pod",
+			"continue": "abc",
+		}))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestPartialObjectMetadataListToUnstructured(t *testing.T) {
+	remaining := int64(7)
+	metadataList := &metav1.PartialObjectMetadataList{
+		ListMeta: metav1.ListMeta{Continue: "next-page", RemainingItemCount: &remaining},
+		Items: []metav1.PartialObjectMetadata{
+			{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "api-1", Namespace: "backend"}},
+		},
+	}
+
+	list, err := partialObjectMetadataListToUnstructured(metadataList)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if token, _, _ := unstructured.NestedString(list.Object, "metadata", "continue"); token != "next-page" {
+		t.Errorf("expected continue token 'next-page', got %q", token)
+	}
+	if count, _, _ := unstructured.NestedInt64(list.Object, "metadata", "remainingItemCount"); count != remaining {
+		t.Errorf("expected remainingItemCount %d, got %d", remaining, count)
+	}
+
+	// Metadata fields remain usable by the client-side filter
+	list.Items = filterUnstructuredItems(list.Items, fields.OneTermEqualSelector("metadata.namespace", "backend"))
+	got := mapToMinimalResourceListContent(list)
+	want := []any{MinimalResourceContent{Name: "api-1", Namespace: "backend"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestExtractListK8sResourcesParamsAggregate(t *testing.T) {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{
+		"context":   "prod",
+		"kind":      "Pod",
+		"aggregate": true,
+	}
+	if _, err := extractListK8sResourcesParams(request); err == nil {
+		t.Fatal("expected error for aggregate on a non-Event kind, got nil")
+	}
+
+	// Kinds resolve case-insensitively, so aggregate accepts any casing of Event
+	request.Params.Arguments = map[string]any{
+		"context":   "prod",
+		"kind":      "event",
+		"aggregate": true,
+	}
+	if _, err := extractListK8sResourcesParams(request); err != nil {
+		t.Fatalf("unexpected error for aggregate on kind event: %v", err)
+	}
+}
+
+func TestExtractListK8sResourcesParamsNamePrefix(t *testing.T) {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{
+		"context":    "prod",
+		"kind":       "Pod",
+		"namePrefix": "frontend-[",
+	}
+	if _, err := extractListK8sResourcesParams(request); err == nil {
+		t.Fatal("expected error for an invalid name glob, got nil")
+	}
+}
+
+func TestExtractListK8sResourcesParamsRaw(t *testing.T) {
+	for _, conflict := range []string{"minimal", "aggregate"} {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{
+			"context": "prod",
+			"kind":    "Event",
+			"raw":     true,
+			conflict:  true,
+		}
+		if _, err := extractListK8sResourcesParams(request); err == nil {
+			t.Errorf("expected error for raw with %s, got nil", conflict)
+		}
+	}
+}
+
+func TestMapToRawResourceListContent(t *testing.T) {
+	newList := func() *unstructured.UnstructuredList {
+		item := unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "web"},
+			"spec":     map[string]any{"replicas": int64(3)},
+		}}
+		item.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+		return &unstructured.UnstructuredList{Items: []unstructured.Unstructured{item}}
+	}
+
+	content := mapToRawResourceListContent(newList(), false)
+	if len(content) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(content))
+	}
+	object, ok := content[0].(map[string]any)
+	if !ok {
+		t.Fatalf("expected full object, got %T", content[0])
+	}
+	if replicas, _, _ := unstructured.NestedInt64(object, "spec", "replicas"); replicas != 3 {
+		t.Errorf("expected spec.replicas 3, got %d", replicas)
+	}
+	if _, found, _ := unstructured.NestedFieldNoCopy(object, "metadata", "managedFields"); found {
+		t.Error("expected managedFields to be stripped")
+	}
+
+	kept := mapToRawResourceListContent(newList(), true)[0].(map[string]any)
+	if _, found, _ := unstructured.NestedFieldNoCopy(kept, "metadata", "managedFields"); !found {
+		t.Error("expected managedFields to be kept with includeManagedFields")
+	}
+}
+
+func TestExtractListK8sResourcesParamsLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       any
+		wantLimit   int64
+		wantWarning bool
+		wantErr     bool
+	}{
+		{name: "default", wantLimit: defaultListLimit},
+		{name: "within cap", limit: float64(250), wantLimit: 250},
+		{name: "above cap", limit: float64(10_000), wantLimit: DefaultMaxListLimit, wantWarning: true},
+		{name: "unlimited", limit: float64(0), wantLimit: DefaultMaxListLimit, wantWarning: true},
+		{name: "fractional", limit: 10.5, wantErr: true},
+		{name: "NaN string", limit: "NaN", wantErr: true},
+		{name: "not a number", limit: "lots", wantErr: true},
+		{name: "negative", limit: float64(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			args := map[string]any{"context": "prod", "kind": "Pod"}
+			if tt.limit != nil {
+				args["limit"] = tt.limit
+			}
+			request.Params.Arguments = args
+
+			params, err := extractListK8sResourcesParams(request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got limit %d", params.Limit)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if params.Limit != tt.wantLimit {
+				t.Errorf("expected limit %d, got %d", tt.wantLimit, params.Limit)
+			}
+			if (params.LimitWarning != "") != tt.wantWarning {
+				t.Errorf("expected warning: %v, got %q", tt.wantWarning, params.LimitWarning)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+	"github.com/krmcbride/mcp-k8s/internal/tools/mapper"
+)
+
+const (
+	minRestartsProperty = "minRestarts"
+	windowProperty      = "window"
+)
+
+const (
+	defaultMinRestarts     = 1
+	defaultRestartWindow   = time.Hour
+	defaultRestartingLimit = 50
+)
+
+type listK8sRestartingPodsParams struct {
+	Context       string
+	Namespace     string
+	LabelSelector string
+	MinRestarts   int64
+	Window        time.Duration // 0 means any time
+	Limit         int64
+}
+
+func RegisterListK8sRestartingPodsMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sRestartingPodsMCPTool(), listK8sRestartingPodsHandler)
+}
+
+// Tool schema
+func newListK8sRestartingPodsMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_restarting_pods", readOnlyToolOptions(
+		mcp.WithDescription("Find unstable pods across the cluster (or one namespace): returns only pods with at least minRestarts container restarts whose most recent restart "+
+			"falls within the window, sorted by restarts, with OOM kills and the last termination reason. "+
+			"Restart counts are cumulative over the pod's lifetime; the window filters on the time of the most recent restart. Start here when looking for crash-looping workloads."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("Optional namespace to narrow the search to. Defaults to all namespaces."),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Optional label selector to narrow the pods (e.g. 'app=web')."),
+		),
+		mcp.WithNumber(minRestartsProperty,
+			mcp.Description(fmt.Sprintf("Minimum total container restarts for a pod to be included. Defaults to %d.", defaultMinRestarts)),
+		),
+		mcp.WithString(windowProperty,
+			mcp.Description(fmt.Sprintf("Only include pods whose most recent restart was within this duration (e.g. '30m', '6h'). Defaults to %s; '0' includes restarts at any time.", defaultRestartWindow)),
+		),
+		mcp.WithNumber(limitProperty,
+			mcp.Description(fmt.Sprintf("Maximum number of pods to return, most restarts first. Defaults to %d; 0 returns all.", defaultRestartingLimit)),
+		),
+	)...)
+}
+
+// Tool handler
+func listK8sRestartingPodsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractListK8sRestartingPodsParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Without a namespace this is an all-namespaces query; explicit namespaces are checked by addTool
+	if params.Namespace == metav1.NamespaceAll {
+		if err := CheckNamespaceAccess(metav1.NamespaceAll); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	pods, err := listPods(ctx, dynamicClient, params.Namespace, params.LabelSelector)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("list", podGVR, params.Namespace, "", err).Error()), nil
+	}
+
+	restarting := filterRestartingPods(pods, params.MinRestarts, params.Window, time.Now())
+
+	response := map[string]any{
+		"scannedPods": len(pods),
+	}
+	if params.Limit > 0 && int64(len(restarting)) > params.Limit {
+		response["truncated"] = fmt.Sprintf("showing the %d pods with the most restarts of %d matching; raise limit to see more", params.Limit, len(restarting))
+		restarting = restarting[:params.Limit]
+	}
+	response["count"] = len(restarting)
+	response["items"] = restarting
+
+	// Return as JSON
+	return toJSONToolResult(response)
+}
+
+// listPods lists every pod in a namespace (or all namespaces when empty), following continue
+// tokens so large clusters aren't silently truncated
+func listPods(ctx context.Context, dynamicClient dynamic.Interface, namespace, labelSelector string) ([]unstructured.Unstructured, error) {
+	listOptions := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		Limit:         countPageSize,
+	}
+
+	var pods []unstructured.Unstructured
+	for {
+		page, err := dynamicClient.Resource(podGVR).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, page.Items...)
+
+		if page.GetContinue() == "" {
+			return pods, nil
+		}
+		listOptions.Continue = page.GetContinue()
+	}
+}
+
+// filterRestartingPods maps pods with the Pod mapper and keeps those with at least minRestarts
+// restarts whose most recent restart is within window of now (any time when window is 0),
+// sorted by restarts, then OOM kills, then most recent restart
+func filterRestartingPods(pods []unstructured.Unstructured, minRestarts int64, window time.Duration, now time.Time) []mapper.PodListContent {
+	restarting := []mapper.PodListContent{}
+	for _, content := range mapToK8sResourceListContent(&unstructured.UnstructuredList{Items: pods}, podGVK) {
+		pod, ok := content.(mapper.PodListContent)
+		if !ok || pod.Restarts < minRestarts {
+			continue
+		}
+		if window > 0 {
+			// Pods without a recorded termination time can't be placed in the window
+			lastRestart, err := time.Parse(time.RFC3339, pod.LastRestartAt)
+			if err != nil || now.Sub(lastRestart) > window {
+				continue
+			}
+		}
+		restarting = append(restarting, pod)
+	}
+
+	sort.SliceStable(restarting, func(i, j int) bool {
+		a, b := restarting[i], restarting[j]
+		if a.Restarts != b.Restarts {
+			return a.Restarts > b.Restarts
+		}
+		if a.OOMKills != b.OOMKills {
+			return a.OOMKills > b.OOMKills
+		}
+		// RFC3339 timestamps in UTC sort chronologically as strings
+		return a.LastRestartAt > b.LastRestartAt
+	})
+	return restarting
+}
+
+func extractListK8sRestartingPodsParams(request mcp.CallToolRequest) (*listK8sRestartingPodsParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	minRestarts := request.GetInt(minRestartsProperty, defaultMinRestarts)
+	if minRestarts < 1 {
+		return nil, fmt.Errorf("%s must be at least 1, got %d", minRestartsProperty, minRestarts)
+	}
+
+	window := defaultRestartWindow
+	if windowStr := request.GetString(windowProperty, ""); windowStr != "" {
+		window, err = time.ParseDuration(windowStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid window duration: %w", err)
+		}
+		if window < 0 {
+			return nil, fmt.Errorf("window must not be negative, got %s", windowStr)
+		}
+	}
+
+	limit, err := extractLimit(request, defaultRestartingLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listK8sRestartingPodsParams{
+		Context:       context,
+		Namespace:     request.GetString(namespaceProperty, ""),
+		LabelSelector: request.GetString(labelSelectorProperty, ""),
+		MinRestarts:   int64(minRestarts),
+		Window:        window,
+		Limit:         limit,
+	}, nil
+}
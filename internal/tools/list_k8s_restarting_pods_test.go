@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFilterRestartingPods(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	newPod := func(name string, restarts int64, lastReason string, finishedAt time.Time) unstructured.Unstructured {
+		status := map[string]any{"name": "app", "ready": true, "restartCount": restarts}
+		if !finishedAt.IsZero() {
+			status["lastState"] = map[string]any{"terminated": map[string]any{"reason": lastReason, "finishedAt": finishedAt.Format(time.RFC3339)}}
+		}
+		return unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": name, "namespace": "default"},
+			"status":   map[string]any{"phase": "Running", "containerStatuses": []any{status}},
+		}}
+	}
+	pods := []unstructured.Unstructured{
+		newPod("stable", 0, "", time.Time{}),
+		newPod("old-restarts", 9, "Error", now.Add(-3*time.Hour)),
+		newPod("crashing", 12, "Error", now.Add(-2*time.Minute)),
+		newPod("oom", 5, "OOMKilled", now.Add(-10*time.Minute)),
+		newPod("flaky", 5, "Error", now.Add(-5*time.Minute)),
+		newPod("once", 1, "Error", now.Add(-30*time.Minute)),
+	}
+
+	tests := []struct {
+		name        string
+		minRestarts int64
+		window      time.Duration
+		want        []string
+	}{
+		{name: "within window, ranked by restarts then OOM kills", minRestarts: 1, window: time.Hour, want: []string{"crashing", "oom", "flaky", "once"}},
+		{name: "threshold", minRestarts: 5, window: time.Hour, want: []string{"crashing", "oom", "flaky"}},
+		{name: "any time", minRestarts: 6, window: 0, want: []string{"crashing", "old-restarts"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, pod := range filterRestartingPods(pods, tt.minRestarts, tt.window, now) {
+				got = append(got, pod.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
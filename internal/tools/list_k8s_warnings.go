@@ -0,0 +1,164 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// defaultWarningsLimit caps the number of warnings returned, most recent first
+const defaultWarningsLimit = 50
+
+var (
+	eventGVR = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+	eventGVK = schema.GroupVersionKind{Version: "v1", Kind: "Event"}
+)
+
+type listK8sWarningsParams struct {
+	Context   string
+	Namespace string
+	Aggregate bool
+	Limit     int64
+}
+
+func RegisterListK8sWarningsMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sWarningsMCPTool(), listK8sWarningsHandler)
+}
+
+// Tool schema
+func newListK8sWarningsMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_warnings", readOnlyToolOptions(
+		mcp.WithDescription("List Warning events across all namespaces, most recent first, like kubectl get events -A --field-selector type=Warning. "+
+			"By default events are grouped by reason and involved object with their counts summed, giving a compact cluster-wide feed of what's broken. "+
+			"Start here when asked what is wrong with a cluster."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("Optional namespace to narrow the warnings to. Defaults to all namespaces."),
+		),
+		mcp.WithBoolean(aggregateProperty,
+			mcp.Description("Group events by reason and involved object, summing their counts and reporting first/last seen and the latest message. "+
+				"Defaults to true; set to false to return individual Event objects."),
+		),
+		mcp.WithNumber(limitProperty,
+			mcp.Description(fmt.Sprintf("Maximum number of warnings to return, most recent first. Defaults to %d; 0 returns all.", defaultWarningsLimit)),
+		),
+	)...)
+}
+
+// Tool handler
+func listK8sWarningsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractListK8sWarningsParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Without a namespace this is an all-namespaces query; explicit namespaces are checked by addTool
+	if params.Namespace == metav1.NamespaceAll {
+		if err := CheckNamespaceAccess(metav1.NamespaceAll); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	events, err := listWarningEvents(ctx, dynamicClient, params.Namespace)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("list", eventGVR, params.Namespace, "", err).Error()), nil
+	}
+
+	// Map to aggregated entries or individual events, most recent first
+	var items []any
+	if params.Aggregate {
+		for _, event := range aggregateEvents(events) {
+			items = append(items, event)
+		}
+	} else {
+		sortEventsByLastSeen(events)
+		items = mapToK8sResourceListContent(&unstructured.UnstructuredList{Items: events}, eventGVK)
+	}
+
+	response := map[string]any{
+		"warningEvents": len(events),
+	}
+	if params.Limit > 0 && int64(len(items)) > params.Limit {
+		response["truncated"] = fmt.Sprintf("showing the %d most recent of %d warnings; raise limit to see more", params.Limit, len(items))
+		items = items[:params.Limit]
+	}
+	if items == nil {
+		items = []any{}
+	}
+	response["count"] = len(items)
+	response["items"] = items
+
+	// Return as JSON
+	return toJSONToolResult(response)
+}
+
+// listWarningEvents lists every Warning event in a namespace (or all namespaces when empty),
+// following continue tokens so busy clusters aren't silently truncated
+func listWarningEvents(ctx context.Context, dynamicClient dynamic.Interface, namespace string) ([]unstructured.Unstructured, error) {
+	listOptions := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", "Warning").String(),
+		Limit:         countPageSize,
+	}
+
+	var events []unstructured.Unstructured
+	for {
+		page, err := dynamicClient.Resource(eventGVR).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page.Items...)
+
+		if page.GetContinue() == "" {
+			return events, nil
+		}
+		listOptions.Continue = page.GetContinue()
+	}
+}
+
+// sortEventsByLastSeen orders events by their last occurrence, most recent first
+func sortEventsByLastSeen(events []unstructured.Unstructured) {
+	sort.SliceStable(events, func(i, j int) bool {
+		_, lastI := eventTimes(events[i])
+		_, lastJ := eventTimes(events[j])
+		return lastI.After(lastJ)
+	})
+}
+
+func extractListK8sWarningsParams(request mcp.CallToolRequest) (*listK8sWarningsParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := extractLimit(request, defaultWarningsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listK8sWarningsParams{
+		Context:   context,
+		Namespace: request.GetString(namespaceProperty, ""),
+		Aggregate: request.GetBool(aggregateProperty, true),
+		Limit:     limit,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newWarningEvent(name, namespace, lastTimestamp string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]any{
+		"apiVersion":     "v1",
+		"kind":           "Event",
+		"metadata":       map[string]any{"name": name, "namespace": namespace},
+		"type":           "Warning",
+		"reason":         "BackOff",
+		"involvedObject": map[string]any{"kind": "Pod", "name": name},
+		"lastTimestamp":  lastTimestamp,
+	}}
+}
+
+func TestListWarningEventsFollowsContinue(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{eventGVR: "EventList"})
+
+	var fieldSelectors []string
+	client.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		restrictions := action.(k8stesting.ListAction).GetListRestrictions()
+		fieldSelectors = append(fieldSelectors, restrictions.Fields.String())
+
+		list := &unstructured.UnstructuredList{Object: map[string]any{"apiVersion": "v1", "kind": "EventList"}}
+		if len(fieldSelectors) == 1 {
+			list.Items = []unstructured.Unstructured{
+				newWarningEvent("a", "default", "2025-06-01T10:00:00Z"),
+				newWarningEvent("b", "kube-system", "2025-06-01T11:00:00Z"),
+			}
+			list.SetContinue("page-2")
+		} else {
+			list.Items = []unstructured.Unstructured{newWarningEvent("c", "default", "2025-06-01T09:00:00Z")}
+		}
+		return true, list, nil
+	})
+
+	events, err := listWarningEvents(context.Background(), client, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 3 {
+		t.Errorf("expected 3 events across both pages, got %d", len(events))
+	}
+	for _, selector := range fieldSelectors {
+		if selector != "type=Warning" {
+			t.Errorf("expected field selector type=Warning, got %q", selector)
+		}
+	}
+
+	sortEventsByLastSeen(events)
+	var names []string
+	for _, event := range events {
+		names = append(names, event.GetName())
+	}
+	if got := names; len(got) != 3 || got[0] != "b" || got[1] != "a" || got[2] != "c" {
+		t.Errorf("expected events ordered most recent first [b a c], got %v", got)
+	}
+}
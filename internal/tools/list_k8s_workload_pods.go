@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+// workloadGVRs maps the workload kinds supported by list_k8s_workload_pods to their resources
+var workloadGVRs = map[string]schema.GroupVersionResource{
+	rolloutKindDeployment:  {Group: "apps", Version: "v1", Resource: "deployments"},
+	rolloutKindStatefulSet: {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	rolloutKindDaemonSet:   {Group: "apps", Version: "v1", Resource: "daemonsets"},
+}
+
+type listK8sWorkloadPodsParams struct {
+	Context   string
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// WorkloadPodsResult lists the pods selected by a workload's spec.selector
+type WorkloadPodsResult struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Selector  string `json:"selector"` // The workload's selector in label selector syntax, including set-based expressions
+	Count     int    `json:"count"`
+	Pods      []any  `json:"pods"`
+}
+
+func RegisterListK8sWorkloadPodsMCPTool(s *server.MCPServer) {
+	addTool(s, newListK8sWorkloadPodsMCPTool(), listK8sWorkloadPodsHandler)
+}
+
+// Tool schema
+func newListK8sWorkloadPodsMCPTool() mcp.Tool {
+	return mcp.NewTool("list_k8s_workload_pods", readOnlyToolOptions(
+		mcp.WithDescription("List the pods belonging to a Deployment, StatefulSet, or DaemonSet. Resolves the workload's spec.selector (including set-based matchExpressions) "+
+			"into a label selector and lists the matching pods, so the selector doesn't have to be read and rebuilt by hand."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace of the workload."),
+			mcp.Required(),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The workload kind."),
+			mcp.Required(),
+			mcp.Enum(rolloutKindDeployment, rolloutKindStatefulSet, rolloutKindDaemonSet),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("The name of the workload."),
+			mcp.Required(),
+		),
+	)...)
+}
+
+// Tool handler
+func listK8sWorkloadPodsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractListK8sWorkloadPodsParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	// Get the workload
+	gvr := workloadGVRs[params.Kind]
+	workload, err := getK8sResource(ctx, dynamicClient, gvr, params.Namespace, params.Name)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("get", gvr, params.Namespace, params.Name, err).Error()), nil
+	}
+
+	result, err := listWorkloadPods(ctx, dynamicClient, workload)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result.Kind = params.Kind
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+func extractListK8sWorkloadPodsParams(request mcp.CallToolRequest) (*listK8sWorkloadPodsParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := request.RequireString(namespaceProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalize kind so "deployment" and "Deployment" are equivalent
+	switch strings.ToLower(kind) {
+	case strings.ToLower(rolloutKindDeployment):
+		kind = rolloutKindDeployment
+	case strings.ToLower(rolloutKindStatefulSet):
+		kind = rolloutKindStatefulSet
+	case strings.ToLower(rolloutKindDaemonSet):
+		kind = rolloutKindDaemonSet
+	default:
+		return nil, fmt.Errorf("unsupported kind '%s': must be one of %s, %s, %s", kind, rolloutKindDeployment, rolloutKindStatefulSet, rolloutKindDaemonSet)
+	}
+
+	name, err := request.RequireString(nameProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listK8sWorkloadPodsParams{
+		Context:   context,
+		Namespace: namespace,
+		Kind:      kind,
+		Name:      name,
+	}, nil
+}
+
+// listWorkloadPods lists the pods matched by a workload's spec.selector in its namespace,
+// mapped with the Pod mapper
+func listWorkloadPods(ctx context.Context, dynamicClient dynamic.Interface, workload *unstructured.Unstructured) (*WorkloadPodsResult, error) {
+	// An empty or missing selector would match every pod in the namespace
+	if selector, found, _ := unstructured.NestedMap(workload.Object, "spec", "selector"); !found || len(selector) == 0 {
+		return nil, fmt.Errorf("%s %q has no spec.selector", workload.GetKind(), workload.GetName())
+	}
+
+	selector, err := workloadSelector(workload)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := dynamicClient.Resource(podGVR).Namespace(workload.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, categorizeK8sError("list", podGVR, workload.GetNamespace(), "", err)
+	}
+
+	return &WorkloadPodsResult{
+		Kind:      workload.GetKind(),
+		Name:      workload.GetName(),
+		Namespace: workload.GetNamespace(),
+		Selector:  selector.String(),
+		Count:     len(pods.Items),
+		Pods:      mapToK8sResourceListContent(pods, podGVK),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/krmcbride/mcp-k8s/internal/tools/mapper"
+)
+
+func TestListWorkloadPods(t *testing.T) {
+	newPod := func(name string, labels map[string]any) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": name, "namespace": "default", "labels": labels},
+		}}
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podGVR: "PodList"},
+		newPod("web-a", map[string]any{"app": "web", "track": "stable"}),
+		newPod("web-canary", map[string]any{"app": "web", "track": "canary"}),
+		newPod("api-a", map[string]any{"app": "api", "track": "stable"}),
+	)
+
+	workload := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "web", "namespace": "default"},
+		"spec": map[string]any{
+			"selector": map[string]any{
+				"matchLabels": map[string]any{"app": "web"},
+				"matchExpressions": []any{
+					map[string]any{"key": "track", "operator": "NotIn", "values": []any{"canary"}},
+				},
+			},
+		},
+	}}
+
+	result, err := listWorkloadPods(context.Background(), client, workload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Selector != "app=web,track notin (canary)" {
+		t.Errorf("unexpected selector %q", result.Selector)
+	}
+	if result.Count != 1 {
+		t.Fatalf("expected 1 pod, got %d", result.Count)
+	}
+	if pod, ok := result.Pods[0].(mapper.PodListContent); !ok || pod.Name != "web-a" {
+		t.Errorf("expected mapped pod web-a, got %+v", result.Pods[0])
+	}
+}
+
+func TestListWorkloadPodsRequiresSelector(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podGVR: "PodList"})
+
+	workload := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "web", "namespace": "default"},
+		"spec":       map[string]any{},
+	}}
+
+	if _, err := listWorkloadPods(context.Background(), client, workload); err == nil {
+		t.Fatal("expected error for a workload without a selector, got nil")
+	}
+}
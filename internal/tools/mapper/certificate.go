@@ -0,0 +1,100 @@
+package mapper
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CertificateListContent represents cert-manager Certificate-specific fields for list display
+type CertificateListContent struct {
+	Name        string   `json:"name"`
+	Namespace   string   `json:"namespace,omitempty"`
+	Ready       string   `json:"ready,omitempty"`
+	Reason      string   `json:"reason,omitempty"`
+	Message     string   `json:"message,omitempty"`
+	SecretName  string   `json:"secretName,omitempty"`
+	Issuer      string   `json:"issuer,omitempty"`
+	DNSNames    []string `json:"dnsNames,omitempty"`
+	NotAfter    string   `json:"notAfter,omitempty"`
+	ExpiresIn   string   `json:"expiresIn,omitempty"`
+	Expired     bool     `json:"expired,omitempty"`
+	RenewalTime string   `json:"renewalTime,omitempty"`
+}
+
+func init() {
+	// Register cert-manager Certificate mapper
+	Register(
+		schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+		mapCertificateResource,
+	)
+}
+
+func mapCertificateResource(item unstructured.Unstructured) any {
+	certificate := CertificateListContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+
+	// Extract spec fields
+	if secretName, found, _ := unstructured.NestedString(item.Object, "spec", "secretName"); found {
+		certificate.SecretName = secretName
+	}
+
+	if issuerName, found, _ := unstructured.NestedString(item.Object, "spec", "issuerRef", "name"); found {
+		certificate.Issuer = issuerName
+		if issuerKind, found, _ := unstructured.NestedString(item.Object, "spec", "issuerRef", "kind"); found && issuerKind != "" {
+			certificate.Issuer = issuerKind + "/" + issuerName
+		}
+	}
+
+	if dnsNames, found, _ := unstructured.NestedStringSlice(item.Object, "spec", "dnsNames"); found {
+		certificate.DNSNames = dnsNames
+	}
+
+	// Extract Ready condition
+	if conditions, found, _ := unstructured.NestedSlice(item.Object, "status", "conditions"); found {
+		for _, condition := range conditions {
+			if condMap, ok := condition.(map[string]any); ok {
+				if condType, found, _ := unstructured.NestedString(condMap, "type"); found && condType == "Ready" {
+					if status, found, _ := unstructured.NestedString(condMap, "status"); found {
+						certificate.Ready = status
+					}
+					if reason, found, _ := unstructured.NestedString(condMap, "reason"); found {
+						certificate.Reason = reason
+					}
+					if message, found, _ := unstructured.NestedString(condMap, "message"); found {
+						certificate.Message = message
+					}
+				}
+			}
+		}
+	}
+
+	// Extract expiry and calculate time remaining
+	if notAfter, found, _ := unstructured.NestedString(item.Object, "status", "notAfter"); found {
+		certificate.NotAfter = notAfter
+		certificate.ExpiresIn, certificate.Expired = certificateExpiry(notAfter, time.Now())
+	}
+
+	if renewalTime, found, _ := unstructured.NestedString(item.Object, "status", "renewalTime"); found {
+		certificate.RenewalTime = renewalTime
+	}
+
+	return certificate
+}
+
+// certificateExpiry returns the time left until an RFC 3339 notAfter as of now, or expired once
+// it has passed. Malformed timestamps yield neither.
+func certificateExpiry(notAfter string, now time.Time) (string, bool) {
+	parsed, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return "", false
+	}
+	remaining := parsed.Sub(now)
+	if remaining <= 0 {
+		return "", true
+	}
+	return formatDuration(remaining), false
+}
@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCertificateExpiry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		notAfter      string
+		wantExpiresIn string
+		wantExpired   bool
+	}{
+		{name: "valid", notAfter: "2025-06-11T12:00:00Z", wantExpiresIn: "10d"},
+		{name: "expiring within the hour", notAfter: "2025-06-01T12:30:00Z", wantExpiresIn: "30m"},
+		{name: "expired", notAfter: "2025-05-31T12:00:00Z", wantExpired: true},
+		{name: "expires now", notAfter: "2025-06-01T12:00:00Z", wantExpired: true},
+		{name: "malformed", notAfter: "next tuesday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiresIn, expired := certificateExpiry(tt.notAfter, now)
+			if expiresIn != tt.wantExpiresIn || expired != tt.wantExpired {
+				t.Errorf("got (%q, %v), want (%q, %v)", expiresIn, expired, tt.wantExpiresIn, tt.wantExpired)
+			}
+		})
+	}
+}
+
+func TestMapCertificateResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "web-tls", "namespace": "shop"},
+		"spec": map[string]any{
+			"secretName": "web-tls",
+			"issuerRef":  map[string]any{"name": "letsencrypt", "kind": "ClusterIssuer"},
+			"dnsNames":   []any{"shop.example.com"},
+		},
+		"status": map[string]any{
+			"notAfter": "2020-01-01T00:00:00Z",
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "False", "reason": "Expired", "message": "Certificate expired"},
+			},
+		},
+	}}
+
+	got := mapCertificateResource(item).(CertificateListContent)
+	if got.Issuer != "ClusterIssuer/letsencrypt" || got.SecretName != "web-tls" || len(got.DNSNames) != 1 {
+		t.Errorf("unexpected spec fields: %+v", got)
+	}
+	if got.Ready != "False" || got.Reason != "Expired" || !got.Expired || got.ExpiresIn != "" {
+		t.Errorf("unexpected status fields: %+v", got)
+	}
+}
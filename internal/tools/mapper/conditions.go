@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// failingConditionCheck identifies a condition type and the status that means it is failing,
+// e.g. Available=False or ReplicaFailure=True. An empty Type matches any condition type.
+type failingConditionCheck struct {
+	Type          string
+	FailingStatus string
+}
+
+// failingCondition returns the reason and message of the first condition in checks (in order of
+// priority) whose status is failing. Found is false when no checked condition is failing.
+func failingCondition(item unstructured.Unstructured, checks ...failingConditionCheck) (reason, message string, found bool) {
+	conditions, ok, _ := unstructured.NestedSlice(item.Object, "status", "conditions")
+	if !ok {
+		return "", "", false
+	}
+
+	for _, check := range checks {
+		for _, condition := range conditions {
+			condMap, ok := condition.(map[string]any)
+			if !ok {
+				continue
+			}
+			condType, _, _ := unstructured.NestedString(condMap, "type")
+			status, _, _ := unstructured.NestedString(condMap, "status")
+			if (check.Type != "" && condType != check.Type) || status != check.FailingStatus {
+				continue
+			}
+			reason, _, _ = unstructured.NestedString(condMap, "reason")
+			message, _, _ = unstructured.NestedString(condMap, "message")
+			return reason, message, true
+		}
+	}
+	return "", "", false
+}
@@ -1,19 +1,27 @@
 package mapper
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // CronJobListContent represents CronJob-specific fields for list display
 type CronJobListContent struct {
-	Name         string `json:"name"`
-	Namespace    string `json:"namespace,omitempty"`
-	Schedule     string `json:"schedule,omitempty"`
-	Suspend      bool   `json:"suspend,omitempty"`
-	Active       int64  `json:"active,omitempty"`
-	LastSchedule string `json:"lastSchedule,omitempty"`
-	Age          string `json:"age,omitempty"`
+	Name         string              `json:"name"`
+	Namespace    string              `json:"namespace,omitempty"`
+	Schedule     string              `json:"schedule,omitempty"`
+	Suspend      bool                `json:"suspend,omitempty"`
+	Active       int64               `json:"active,omitempty"`
+	LastSchedule string              `json:"lastSchedule,omitempty"`
+	LastAgo      string              `json:"lastAgo,omitempty"`      // Time since the last scheduled run
+	NextSchedule string              `json:"nextSchedule,omitempty"` // Estimated next run from spec.schedule
+	NextIn       string              `json:"nextIn,omitempty"`       // Time until the next scheduled run
+	Age          string              `json:"age,omitempty"`
+	Template     *PodTemplateSummary `json:"template,omitempty"`
 }
 
 func init() {
@@ -46,9 +54,42 @@ func mapCronJobResource(item unstructured.Unstructured) any {
 
 	if lastScheduleTime, found, _ := unstructured.NestedString(item.Object, "status", "lastScheduleTime"); found {
 		cronJob.LastSchedule = lastScheduleTime
+		if parsed, err := time.Parse(time.RFC3339, lastScheduleTime); err == nil {
+			cronJob.LastAgo = formatDuration(time.Since(parsed))
+		}
+	}
+
+	// Estimate the next run (suspended CronJobs never run)
+	if cronJob.Schedule != "" && !cronJob.Suspend {
+		timeZone, _, _ := unstructured.NestedString(item.Object, "spec", "timeZone")
+		if next, err := nextCronSchedule(cronJob.Schedule, timeZone, time.Now()); err == nil {
+			cronJob.NextSchedule = next.UTC().Format(time.RFC3339)
+			cronJob.NextIn = formatDuration(time.Until(next))
+		}
 	}
 
+	// Summarize the pod template (containers, images and aggregate resources)
+	cronJob.Template = summarizePodTemplate(item, "spec", "jobTemplate", "spec", "template", "spec")
+
 	// TODO: Calculate age from creation timestamp
 
 	return cronJob
 }
+
+// nextCronSchedule returns the next time the schedule fires after now, honoring spec.timeZone
+func nextCronSchedule(schedule, timeZone string, now time.Time) (time.Time, error) {
+	if timeZone != "" {
+		schedule = fmt.Sprintf("CRON_TZ=%s %s", timeZone, schedule)
+	}
+
+	parsed, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := parsed.Next(now)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("schedule %q never fires", schedule)
+	}
+	return next, nil
+}
@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextCronSchedule(t *testing.T) {
+	now := time.Date(2025, 6, 19, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		schedule string
+		timeZone string
+		expected time.Time
+		wantErr  bool
+	}{
+		{schedule: "0 * * * *", expected: time.Date(2025, 6, 19, 11, 0, 0, 0, time.UTC)},
+		{schedule: "*/15 * * * *", expected: time.Date(2025, 6, 19, 10, 45, 0, 0, time.UTC)},
+		{schedule: "0 0 * * *", expected: time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC)},
+		{schedule: "@hourly", expected: time.Date(2025, 6, 19, 11, 0, 0, 0, time.UTC)},
+		{schedule: "0 12 * * *", timeZone: "Etc/GMT-1", expected: time.Date(2025, 6, 19, 11, 0, 0, 0, time.UTC)},
+		{schedule: "not a schedule", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			next, err := nextCronSchedule(tt.schedule, tt.timeZone, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("nextCronSchedule(%q) expected error, got %v", tt.schedule, next)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("nextCronSchedule(%q) returned error: %v", tt.schedule, err)
+			}
+			if !next.Equal(tt.expected) {
+				t.Errorf("nextCronSchedule(%q) = %v, want %v", tt.schedule, next.UTC(), tt.expected)
+			}
+		})
+	}
+}
@@ -9,15 +9,25 @@ import (
 
 // CustomResourceDefinitionListContent represents CRD-specific fields for list display
 type CustomResourceDefinitionListContent struct {
-	Name      string   `json:"name"`
-	Group     string   `json:"group,omitempty"`
-	Kind      string   `json:"kind,omitempty"`
-	Scope     string   `json:"scope,omitempty"`
-	Versions  []string `json:"versions,omitempty"`
-	Age       string   `json:"age,omitempty"`
-	Singular  string   `json:"singular,omitempty"`
-	Plural    string   `json:"plural,omitempty"`
-	ShortName string   `json:"shortName,omitempty"`
+	Name          string                      `json:"name"`
+	Group         string                      `json:"group,omitempty"`
+	Kind          string                      `json:"kind,omitempty"`
+	Scope         string                      `json:"scope,omitempty"`
+	Versions      []CustomResourceVersionInfo `json:"versions,omitempty"`
+	Established   string                      `json:"established,omitempty"`   // True, False, Unknown
+	NamesAccepted string                      `json:"namesAccepted,omitempty"` // True, False, Unknown
+	Message       string                      `json:"message,omitempty"`       // From the first condition that isn't True
+	Age           string                      `json:"age,omitempty"`
+	Singular      string                      `json:"singular,omitempty"`
+	Plural        string                      `json:"plural,omitempty"`
+	ShortName     string                      `json:"shortName,omitempty"`
+}
+
+// CustomResourceVersionInfo represents a single CRD version and whether it is served or the storage version
+type CustomResourceVersionInfo struct {
+	Name    string `json:"name"`
+	Served  bool   `json:"served"`
+	Storage bool   `json:"storage,omitempty"`
 }
 
 func init() {
@@ -62,16 +72,45 @@ func mapCustomResourceDefinitionResource(item unstructured.Unstructured) any {
 		}
 	}
 
-	// Extract versions from spec.versions
+	// Extract versions with their served/storage flags from spec.versions
 	if versions, found, err := unstructured.NestedSlice(item.Object, "spec", "versions"); err == nil && found {
 		for _, v := range versions {
 			if versionMap, ok := v.(map[string]any); ok {
 				if name, ok := versionMap["name"].(string); ok {
-					content.Versions = append(content.Versions, name)
+					served, _ := versionMap["served"].(bool)
+					storage, _ := versionMap["storage"].(bool)
+					content.Versions = append(content.Versions, CustomResourceVersionInfo{
+						Name:    name,
+						Served:  served,
+						Storage: storage,
+					})
 				}
 			}
 		}
 	}
 
+	// Extract Established and NamesAccepted conditions from status
+	if conditions, found, err := unstructured.NestedSlice(item.Object, "status", "conditions"); err == nil && found {
+		for _, c := range conditions {
+			condMap, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			condType, _, _ := unstructured.NestedString(condMap, "type")
+			status, _, _ := unstructured.NestedString(condMap, "status")
+			switch condType {
+			case "Established":
+				content.Established = status
+			case "NamesAccepted":
+				content.NamesAccepted = status
+			default:
+				continue
+			}
+			if status != "True" && content.Message == "" {
+				content.Message, _, _ = unstructured.NestedString(condMap, "message")
+			}
+		}
+	}
+
 	return content
 }
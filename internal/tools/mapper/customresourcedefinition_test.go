@@ -0,0 +1,52 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapCustomResourceDefinitionResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "widgets.example.com"},
+		"spec": map[string]any{
+			"group": "example.com",
+			"scope": "Namespaced",
+			"names": map[string]any{"kind": "Widget", "plural": "widgets"},
+			"versions": []any{
+				map[string]any{"name": "v1alpha1", "served": false, "storage": false},
+				map[string]any{"name": "v1", "served": true, "storage": true},
+			},
+		},
+		"status": map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "NamesAccepted", "status": "False", "message": "\"widgets\" is already in use"},
+				map[string]any{"type": "Established", "status": "False"},
+			},
+		},
+	}}
+
+	content, ok := mapCustomResourceDefinitionResource(item).(CustomResourceDefinitionListContent)
+	if !ok {
+		t.Fatal("expected CustomResourceDefinitionListContent")
+	}
+
+	if len(content.Versions) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(content.Versions))
+	}
+	if v := content.Versions[0]; v.Name != "v1alpha1" || v.Served || v.Storage {
+		t.Errorf("unexpected v1alpha1 flags: %+v", v)
+	}
+	if v := content.Versions[1]; v.Name != "v1" || !v.Served || !v.Storage {
+		t.Errorf("unexpected v1 flags: %+v", v)
+	}
+	if content.Established != "False" {
+		t.Errorf("expected established False, got %q", content.Established)
+	}
+	if content.NamesAccepted != "False" {
+		t.Errorf("expected namesAccepted False, got %q", content.NamesAccepted)
+	}
+	if content.Message != "\"widgets\" is already in use" {
+		t.Errorf("expected NamesAccepted message, got %q", content.Message)
+	}
+}
@@ -1,20 +1,30 @@
 package mapper
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // DaemonSetListContent represents DaemonSet-specific fields for list display
 type DaemonSetListContent struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace,omitempty"`
-	Desired   int64  `json:"desired,omitempty"`
-	Current   int64  `json:"current,omitempty"`
-	Ready     int64  `json:"ready,omitempty"`
-	UpToDate  int64  `json:"upToDate,omitempty"`
-	Available int64  `json:"available,omitempty"`
-	Age       string `json:"age,omitempty"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace,omitempty"`
+	Desired         int64  `json:"desired,omitempty"`
+	Current         int64  `json:"current,omitempty"`
+	Ready           int64  `json:"ready,omitempty"`
+	UpToDate        int64  `json:"upToDate,omitempty"`
+	Available       int64  `json:"available,omitempty"`
+	Misscheduled    int64  `json:"misscheduled,omitempty"`    // Nodes running the pod that shouldn't (taint/selector problems)
+	RolloutProgress string `json:"rolloutProgress,omitempty"` // Updated/desired pods while a rollout is in progress
+	RolloutComplete bool   `json:"rolloutComplete"`
+	// Reason and message explaining unavailable pods: a failing condition when one is set, since the
+	// DaemonSet controller rarely sets conditions, otherwise a summary of the unavailable count
+	UnavailableReason  string              `json:"unavailableReason,omitempty"`
+	UnavailableMessage string              `json:"unavailableMessage,omitempty"`
+	Age                string              `json:"age,omitempty"`
+	Template           *PodTemplateSummary `json:"template,omitempty"`
 }
 
 func init() {
@@ -52,6 +62,34 @@ func mapDaemonSetResource(item unstructured.Unstructured) any {
 		daemonSet.Available = available
 	}
 
+	if misscheduled, found, _ := unstructured.NestedInt64(item.Object, "status", "numberMisscheduled"); found {
+		daemonSet.Misscheduled = misscheduled
+	}
+
+	// Like kubectl rollout status, the rollout is done once the controller has observed the latest
+	// spec and every desired pod is updated and available
+	generation := item.GetGeneration()
+	observedGeneration, _, _ := unstructured.NestedInt64(item.Object, "status", "observedGeneration")
+	daemonSet.RolloutComplete = observedGeneration >= generation &&
+		daemonSet.UpToDate >= daemonSet.Desired &&
+		daemonSet.Available >= daemonSet.Desired
+	if !daemonSet.RolloutComplete {
+		daemonSet.RolloutProgress = fmt.Sprintf("%d/%d updated", daemonSet.UpToDate, daemonSet.Desired)
+	}
+
+	if unavailable, found, _ := unstructured.NestedInt64(item.Object, "status", "numberUnavailable"); found && unavailable > 0 {
+		if reason, message, found := failingCondition(item, failingConditionCheck{FailingStatus: "False"}); found {
+			daemonSet.UnavailableReason = reason
+			daemonSet.UnavailableMessage = message
+		} else {
+			daemonSet.UnavailableReason = "PodsUnavailable"
+			daemonSet.UnavailableMessage = fmt.Sprintf("%d of %d desired pods are unavailable", unavailable, daemonSet.Desired)
+		}
+	}
+
+	// Summarize the pod template (containers, images and aggregate resources)
+	daemonSet.Template = summarizePodTemplate(item, "spec", "template", "spec")
+
 	// TODO: Calculate age from creation timestamp
 
 	return daemonSet
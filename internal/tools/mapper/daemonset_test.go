@@ -0,0 +1,104 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapDaemonSetResource(t *testing.T) {
+	newDaemonSet := func(generation int64, status map[string]any) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "node-exporter", "namespace": "monitoring", "generation": generation},
+			"status":   status,
+		}}
+	}
+
+	tests := []struct {
+		name             string
+		item             unstructured.Unstructured
+		wantMisscheduled int64
+		wantProgress     string
+		wantComplete     bool
+		wantReason       string
+		wantMessage      string
+	}{
+		{
+			name: "rolled out",
+			item: newDaemonSet(2, map[string]any{
+				"observedGeneration": int64(2), "desiredNumberScheduled": int64(3),
+				"updatedNumberScheduled": int64(3), "numberAvailable": int64(3),
+			}),
+			wantComplete: true,
+		},
+		{
+			name: "rollout in progress",
+			item: newDaemonSet(2, map[string]any{
+				"observedGeneration": int64(2), "desiredNumberScheduled": int64(5),
+				"updatedNumberScheduled": int64(2), "numberAvailable": int64(5),
+			}),
+			wantProgress: "2/5 updated",
+		},
+		{
+			name: "spec change not yet observed",
+			item: newDaemonSet(3, map[string]any{
+				"observedGeneration": int64(2), "desiredNumberScheduled": int64(3),
+				"updatedNumberScheduled": int64(3), "numberAvailable": int64(3),
+			}),
+			wantProgress: "3/3 updated",
+		},
+		{
+			name: "misscheduled pods",
+			item: newDaemonSet(1, map[string]any{
+				"observedGeneration": int64(1), "desiredNumberScheduled": int64(3),
+				"updatedNumberScheduled": int64(3), "numberAvailable": int64(3), "numberMisscheduled": int64(2),
+			}),
+			wantMisscheduled: 2,
+			wantComplete:     true,
+		},
+		{
+			name: "unavailable pods without conditions",
+			item: newDaemonSet(1, map[string]any{
+				"observedGeneration": int64(1), "desiredNumberScheduled": int64(4),
+				"updatedNumberScheduled": int64(4), "numberAvailable": int64(3), "numberUnavailable": int64(1),
+			}),
+			wantProgress: "4/4 updated",
+			wantReason:   "PodsUnavailable",
+			wantMessage:  "1 of 4 desired pods are unavailable",
+		},
+		{
+			name: "unavailable pods with failing condition",
+			item: newDaemonSet(1, map[string]any{
+				"observedGeneration": int64(1), "desiredNumberScheduled": int64(4),
+				"updatedNumberScheduled": int64(4), "numberAvailable": int64(2), "numberUnavailable": int64(2),
+				"conditions": []any{
+					map[string]any{"type": "Healthy", "status": "False", "reason": "ImagePullFailed", "message": "image not found"},
+				},
+			}),
+			wantProgress: "4/4 updated",
+			wantReason:   "ImagePullFailed",
+			wantMessage:  "image not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			daemonSet := mapDaemonSetResource(tt.item).(DaemonSetListContent)
+			if daemonSet.Misscheduled != tt.wantMisscheduled {
+				t.Errorf("Misscheduled = %d, want %d", daemonSet.Misscheduled, tt.wantMisscheduled)
+			}
+			if daemonSet.RolloutProgress != tt.wantProgress {
+				t.Errorf("RolloutProgress = %q, want %q", daemonSet.RolloutProgress, tt.wantProgress)
+			}
+			if daemonSet.RolloutComplete != tt.wantComplete {
+				t.Errorf("RolloutComplete = %v, want %v", daemonSet.RolloutComplete, tt.wantComplete)
+			}
+			if daemonSet.UnavailableReason != tt.wantReason {
+				t.Errorf("UnavailableReason = %q, want %q", daemonSet.UnavailableReason, tt.wantReason)
+			}
+			if daemonSet.UnavailableMessage != tt.wantMessage {
+				t.Errorf("UnavailableMessage = %q, want %q", daemonSet.UnavailableMessage, tt.wantMessage)
+			}
+		})
+	}
+}
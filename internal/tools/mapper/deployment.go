@@ -14,7 +14,21 @@ type DeploymentListContent struct {
 	Ready     string `json:"ready,omitempty"`
 	UpToDate  int64  `json:"upToDate,omitempty"`
 	Available int64  `json:"available,omitempty"`
-	Age       string `json:"age,omitempty"`
+	// Reason and message of the failing condition when the Deployment is unavailable or its
+	// rollout is stuck, e.g. ProgressDeadlineExceeded
+	UnavailableReason  string              `json:"unavailableReason,omitempty"`
+	UnavailableMessage string              `json:"unavailableMessage,omitempty"`
+	Age                string              `json:"age,omitempty"`
+	Template           *PodTemplateSummary `json:"template,omitempty"`
+}
+
+// deploymentFailingConditions are checked in priority order: ReplicaFailure names the most
+// specific cause (e.g. exceeded quota), Progressing=False a stuck rollout, and Available=False
+// too few available replicas
+var deploymentFailingConditions = []failingConditionCheck{
+	{Type: "ReplicaFailure", FailingStatus: "True"},
+	{Type: "Progressing", FailingStatus: "False"},
+	{Type: "Available", FailingStatus: "False"},
 }
 
 func init() {
@@ -48,6 +62,15 @@ func mapDeploymentResource(item unstructured.Unstructured) any {
 		deployment.Available = available
 	}
 
+	// Surface why the Deployment isn't healthy, straight from its conditions
+	if reason, message, found := failingCondition(item, deploymentFailingConditions...); found {
+		deployment.UnavailableReason = reason
+		deployment.UnavailableMessage = message
+	}
+
+	// Summarize the pod template (containers, images and aggregate resources)
+	deployment.Template = summarizePodTemplate(item, "spec", "template", "spec")
+
 	// TODO: Calculate age from creation timestamp
 
 	return deployment
@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapDeploymentResourceUnavailableReason(t *testing.T) {
+	newDeployment := func(conditions ...any) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "web", "namespace": "default"},
+			"status": map[string]any{
+				"replicas": int64(3), "readyReplicas": int64(2), "availableReplicas": int64(2),
+				"conditions": conditions,
+			},
+		}}
+	}
+	condition := func(condType, status, reason, message string) map[string]any {
+		return map[string]any{"type": condType, "status": status, "reason": reason, "message": message}
+	}
+
+	tests := []struct {
+		name        string
+		item        unstructured.Unstructured
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name: "healthy",
+			item: newDeployment(
+				condition("Available", "True", "MinimumReplicasAvailable", "Deployment has minimum availability."),
+				condition("Progressing", "True", "NewReplicaSetAvailable", `ReplicaSet "web-5d4f8" has successfully progressed.`),
+			),
+		},
+		{
+			name: "progress deadline exceeded",
+			item: newDeployment(
+				condition("Available", "True", "MinimumReplicasAvailable", "Deployment has minimum availability."),
+				condition("Progressing", "False", "ProgressDeadlineExceeded", `ReplicaSet "web-7c9b6" has timed out progressing.`),
+			),
+			wantReason:  "ProgressDeadlineExceeded",
+			wantMessage: `ReplicaSet "web-7c9b6" has timed out progressing.`,
+		},
+		{
+			name: "minimum replicas unavailable",
+			item: newDeployment(
+				condition("Available", "False", "MinimumReplicasUnavailable", "Deployment does not have minimum availability."),
+				condition("Progressing", "True", "ReplicaSetUpdated", `ReplicaSet "web-7c9b6" is progressing.`),
+			),
+			wantReason:  "MinimumReplicasUnavailable",
+			wantMessage: "Deployment does not have minimum availability.",
+		},
+		{
+			name: "replica failure preferred",
+			item: newDeployment(
+				condition("Available", "False", "MinimumReplicasUnavailable", "Deployment does not have minimum availability."),
+				condition("ReplicaFailure", "True", "FailedCreate", `pods "web-7c9b6-x2k4p" is forbidden: exceeded quota`),
+			),
+			wantReason:  "FailedCreate",
+			wantMessage: `pods "web-7c9b6-x2k4p" is forbidden: exceeded quota`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := mapDeploymentResource(tt.item).(DeploymentListContent)
+			if deployment.UnavailableReason != tt.wantReason {
+				t.Errorf("UnavailableReason = %q, want %q", deployment.UnavailableReason, tt.wantReason)
+			}
+			if deployment.UnavailableMessage != tt.wantMessage {
+				t.Errorf("UnavailableMessage = %q, want %q", deployment.UnavailableMessage, tt.wantMessage)
+			}
+		})
+	}
+}
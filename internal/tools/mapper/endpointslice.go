@@ -0,0 +1,67 @@
+package mapper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EndpointSliceListContent represents EndpointSlice-specific fields for list display
+type EndpointSliceListContent struct {
+	Name        string   `json:"name"`
+	Namespace   string   `json:"namespace,omitempty"`
+	Service     string   `json:"service,omitempty"`
+	AddressType string   `json:"addressType,omitempty"`
+	Ports       []string `json:"ports,omitempty"`
+	Ready       int64    `json:"ready"`
+	NotReady    int64    `json:"notReady,omitempty"`
+}
+
+func init() {
+	// Register EndpointSlice mapper
+	Register(
+		schema.GroupVersionKind{Group: "discovery.k8s.io", Version: "v1", Kind: "EndpointSlice"},
+		mapEndpointSliceResource,
+	)
+}
+
+func mapEndpointSliceResource(item unstructured.Unstructured) any {
+	endpointSlice := EndpointSliceListContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+		Service:   item.GetLabels()["kubernetes.io/service-name"],
+	}
+
+	if addressType, found, _ := unstructured.NestedString(item.Object, "addressType"); found {
+		endpointSlice.AddressType = addressType
+	}
+
+	// Extract ports
+	if ports, found, _ := unstructured.NestedSlice(item.Object, "ports"); found {
+		for _, p := range ports {
+			if portMap, ok := p.(map[string]any); ok {
+				if port, found, _ := unstructured.NestedInt64(portMap, "port"); found {
+					protocol, _, _ := unstructured.NestedString(portMap, "protocol")
+					endpointSlice.Ports = append(endpointSlice.Ports, fmt.Sprintf("%d/%s", port, protocol))
+				}
+			}
+		}
+	}
+
+	// Count ready vs not-ready addresses. A nil ready condition means ready per the API contract.
+	if endpoints, found, _ := unstructured.NestedSlice(item.Object, "endpoints"); found {
+		for _, e := range endpoints {
+			if endpointMap, ok := e.(map[string]any); ok {
+				addresses, _, _ := unstructured.NestedStringSlice(endpointMap, "addresses")
+				if ready, found, _ := unstructured.NestedBool(endpointMap, "conditions", "ready"); !found || ready {
+					endpointSlice.Ready += int64(len(addresses))
+				} else {
+					endpointSlice.NotReady += int64(len(addresses))
+				}
+			}
+		}
+	}
+
+	return endpointSlice
+}
@@ -64,14 +64,14 @@ func mapEventResource(item unstructured.Unstructured) any {
 	// Extract involved object information
 	if involvedObj, found, _ := unstructured.NestedMap(item.Object, "involvedObject"); found {
 		var objInfo string
-		if kind, exists := involvedObj["kind"]; exists {
-			objInfo = kind.(string)
+		if kind, found, _ := unstructured.NestedString(involvedObj, "kind"); found {
+			objInfo = kind
 		}
-		if name, exists := involvedObj["name"]; exists {
+		if name, found, _ := unstructured.NestedString(involvedObj, "name"); found {
 			if objInfo != "" {
-				objInfo += "/" + name.(string)
+				objInfo += "/" + name
 			} else {
-				objInfo = name.(string)
+				objInfo = name
 			}
 		}
 		event.InvolvedObject = objInfo
@@ -80,14 +80,14 @@ func mapEventResource(item unstructured.Unstructured) any {
 	// Extract source information
 	if source, found, _ := unstructured.NestedMap(item.Object, "source"); found {
 		var sourceInfo string
-		if component, exists := source["component"]; exists {
-			sourceInfo = component.(string)
+		if component, found, _ := unstructured.NestedString(source, "component"); found {
+			sourceInfo = component
 		}
-		if host, exists := source["host"]; exists {
+		if host, found, _ := unstructured.NestedString(source, "host"); found {
 			if sourceInfo != "" {
-				sourceInfo += "@" + host.(string)
+				sourceInfo += "@" + host
 			} else {
-				sourceInfo = host.(string)
+				sourceInfo = host
 			}
 		}
 		event.Source = sourceInfo
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapEventResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "test-event", "namespace": "default"},
+		"type":     "Warning",
+		"reason":   "BackOff",
+		"involvedObject": map[string]any{
+			"kind": "Pod",
+			"name": "web-0",
+		},
+		"source": map[string]any{
+			"component": "kubelet",
+			"host":      "node-1",
+		},
+	}}
+
+	event := mapEventResource(item).(*EventListContent)
+	if event.InvolvedObject != "Pod/web-0" {
+		t.Errorf("InvolvedObject = %q, want %q", event.InvolvedObject, "Pod/web-0")
+	}
+	if event.Source != "kubelet@node-1" {
+		t.Errorf("Source = %q, want %q", event.Source, "kubelet@node-1")
+	}
+}
+
+func TestMapEventResourceMalformedFields(t *testing.T) {
+	// Non-string values should be skipped rather than causing a panic
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "test-event", "namespace": "default"},
+		"involvedObject": map[string]any{
+			"kind": int64(42),
+			"name": "web-0",
+		},
+		"source": map[string]any{
+			"component": map[string]any{"nested": "value"},
+			"host":      true,
+		},
+	}}
+
+	event := mapEventResource(item).(*EventListContent)
+	if event.InvolvedObject != "web-0" {
+		t.Errorf("InvolvedObject = %q, want %q", event.InvolvedObject, "web-0")
+	}
+	if event.Source != "" {
+		t.Errorf("Source = %q, want empty", event.Source)
+	}
+}
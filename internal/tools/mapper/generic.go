@@ -1,19 +1,75 @@
 package mapper
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // GenericK8sResourceContent represents generic fields for any resource
 type GenericK8sResourceContent struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace,omitempty"`
+	Name       string                    `json:"name"`
+	Namespace  string                    `json:"namespace,omitempty"`
+	Ready      *bool                     `json:"ready,omitempty"`
+	Conditions []GenericConditionContent `json:"conditions,omitempty"`
+	Age        string                    `json:"age,omitempty"`
+}
+
+// GenericConditionContent represents a single entry from status.conditions
+type GenericConditionContent struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
 }
 
-// MapGenericK8sResource provides a fallback mapping for resources without custom mappers
+// MapGenericK8sResource provides a fallback mapping for resources without custom mappers.
+// Most Kubernetes-style resources follow the status.conditions convention, so conditions
+// and a Ready (or Available) summary are included when present.
 func MapGenericK8sResource(item unstructured.Unstructured) GenericK8sResourceContent {
-	return GenericK8sResourceContent{
+	content := GenericK8sResourceContent{
 		Name:      item.GetName(),
 		Namespace: item.GetNamespace(),
 	}
+
+	if created := item.GetCreationTimestamp(); !created.IsZero() {
+		content.Age = formatDuration(time.Since(created.Time))
+	}
+
+	// Extract conditions, tracking Ready and Available for the summary flag
+	var ready, available *bool
+	if conditions, found, _ := unstructured.NestedSlice(item.Object, "status", "conditions"); found {
+		for _, condition := range conditions {
+			condMap, ok := condition.(map[string]any)
+			if !ok {
+				continue
+			}
+			condType, _, _ := unstructured.NestedString(condMap, "type")
+			status, _, _ := unstructured.NestedString(condMap, "status")
+			if condType == "" {
+				continue
+			}
+			reason, _, _ := unstructured.NestedString(condMap, "reason")
+			content.Conditions = append(content.Conditions, GenericConditionContent{
+				Type:   condType,
+				Status: status,
+				Reason: reason,
+			})
+
+			isTrue := status == "True"
+			switch condType {
+			case "Ready":
+				ready = &isTrue
+			case "Available":
+				available = &isTrue
+			}
+		}
+	}
+
+	if ready != nil {
+		content.Ready = ready
+	} else {
+		content.Ready = available
+	}
+
+	return content
 }
@@ -0,0 +1,87 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapGenericK8sResource(t *testing.T) {
+	tests := []struct {
+		name           string
+		conditions     []any
+		wantReady      *bool
+		wantConditions int
+	}{
+		{
+			name:           "no conditions",
+			wantReady:      nil,
+			wantConditions: 0,
+		},
+		{
+			name: "ready condition true",
+			conditions: []any{
+				map[string]any{"type": "Ready", "status": "True", "reason": "Reconciled"},
+				map[string]any{"type": "Synced", "status": "True"},
+			},
+			wantReady:      boolPtr(true),
+			wantConditions: 2,
+		},
+		{
+			name: "ready takes precedence over available",
+			conditions: []any{
+				map[string]any{"type": "Available", "status": "True"},
+				map[string]any{"type": "Ready", "status": "False", "reason": "Degraded"},
+			},
+			wantReady:      boolPtr(false),
+			wantConditions: 2,
+		},
+		{
+			name: "available used when ready is absent",
+			conditions: []any{
+				map[string]any{"type": "Available", "status": "True"},
+			},
+			wantReady:      boolPtr(true),
+			wantConditions: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"metadata": map[string]any{
+					"name":              "widget",
+					"namespace":         "default",
+					"creationTimestamp": "2024-01-01T00:00:00Z",
+				},
+			}
+			if tt.conditions != nil {
+				obj["status"] = map[string]any{"conditions": tt.conditions}
+			}
+
+			got := MapGenericK8sResource(unstructured.Unstructured{Object: obj})
+
+			if got.Name != "widget" || got.Namespace != "default" {
+				t.Errorf("unexpected name/namespace: %s/%s", got.Namespace, got.Name)
+			}
+			if got.Age == "" {
+				t.Error("expected age to be set")
+			}
+			if len(got.Conditions) != tt.wantConditions {
+				t.Errorf("expected %d conditions, got %d", tt.wantConditions, len(got.Conditions))
+			}
+			switch {
+			case tt.wantReady == nil && got.Ready != nil:
+				t.Errorf("expected ready to be unset, got %v", *got.Ready)
+			case tt.wantReady != nil && (got.Ready == nil || *got.Ready != *tt.wantReady):
+				t.Errorf("expected ready %v, got %v", *tt.wantReady, got.Ready)
+			}
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,172 @@
+package mapper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HorizontalPodAutoscalerListContent represents HorizontalPodAutoscaler-specific fields for list display
+type HorizontalPodAutoscalerListContent struct {
+	Name            string         `json:"name"`
+	Namespace       string         `json:"namespace,omitempty"`
+	Target          string         `json:"target,omitempty"` // e.g. "Deployment/web"
+	MinReplicas     int64          `json:"minReplicas"`
+	MaxReplicas     int64          `json:"maxReplicas"`
+	CurrentReplicas int64          `json:"currentReplicas"`
+	DesiredReplicas int64          `json:"desiredReplicas"`
+	Metrics         []HPAMetric    `json:"metrics,omitempty"`
+	Conditions      []HPACondition `json:"conditions,omitempty"`
+}
+
+// HPAMetric pairs a metric the HPA scales on with its target and the latest observed value
+type HPAMetric struct {
+	Type    string `json:"type"`              // Resource, ContainerResource, Pods, Object, or External
+	Name    string `json:"name"`              // e.g. "cpu" or "http_requests_per_second"
+	Target  string `json:"target,omitempty"`  // e.g. "80%" for utilization or "500m" for a value
+	Current string `json:"current,omitempty"` // Same format as target; empty until the HPA has observed the metric
+}
+
+// HPACondition is a status condition explaining the HPA's ability to scale
+type HPACondition struct {
+	Type    string `json:"type"` // AbleToScale, ScalingActive, or ScalingLimited
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func init() {
+	// Register HorizontalPodAutoscaler mappers for both supported API versions
+	Register(
+		schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+		mapHorizontalPodAutoscalerResource,
+	)
+	Register(
+		schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"},
+		mapHorizontalPodAutoscalerResource,
+	)
+}
+
+func mapHorizontalPodAutoscalerResource(item unstructured.Unstructured) any {
+	hpa := HorizontalPodAutoscalerListContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+
+	// Extract scale target reference
+	if kind, found, _ := unstructured.NestedString(item.Object, "spec", "scaleTargetRef", "kind"); found {
+		name, _, _ := unstructured.NestedString(item.Object, "spec", "scaleTargetRef", "name")
+		hpa.Target = fmt.Sprintf("%s/%s", kind, name)
+	}
+
+	// minReplicas defaults to 1 when unset
+	hpa.MinReplicas = 1
+	if minReplicas, found, _ := unstructured.NestedInt64(item.Object, "spec", "minReplicas"); found {
+		hpa.MinReplicas = minReplicas
+	}
+	hpa.MaxReplicas, _, _ = unstructured.NestedInt64(item.Object, "spec", "maxReplicas")
+	hpa.CurrentReplicas, _, _ = unstructured.NestedInt64(item.Object, "status", "currentReplicas")
+	hpa.DesiredReplicas, _, _ = unstructured.NestedInt64(item.Object, "status", "desiredReplicas")
+
+	// autoscaling/v1 only supports a CPU utilization target
+	if targetCPU, found, _ := unstructured.NestedInt64(item.Object, "spec", "targetCPUUtilizationPercentage"); found {
+		metric := HPAMetric{Type: "Resource", Name: "cpu", Target: fmt.Sprintf("%d%%", targetCPU)}
+		if currentCPU, found, _ := unstructured.NestedInt64(item.Object, "status", "currentCPUUtilizationPercentage"); found {
+			metric.Current = fmt.Sprintf("%d%%", currentCPU)
+		}
+		hpa.Metrics = append(hpa.Metrics, metric)
+	}
+
+	// autoscaling/v2 metrics, matched with their current values by type and name
+	current := map[string]string{}
+	if currentMetrics, found, _ := unstructured.NestedSlice(item.Object, "status", "currentMetrics"); found {
+		for _, m := range currentMetrics {
+			metricMap, ok := m.(map[string]any)
+			if !ok {
+				continue
+			}
+			metricType, name, source := hpaMetricSource(metricMap)
+			current[metricType+"/"+name] = formatHPAMetricValue(source, "current")
+		}
+	}
+	if specMetrics, found, _ := unstructured.NestedSlice(item.Object, "spec", "metrics"); found {
+		for _, m := range specMetrics {
+			metricMap, ok := m.(map[string]any)
+			if !ok {
+				continue
+			}
+			metricType, name, source := hpaMetricSource(metricMap)
+			hpa.Metrics = append(hpa.Metrics, HPAMetric{
+				Type:    metricType,
+				Name:    name,
+				Target:  formatHPAMetricValue(source, "target"),
+				Current: current[metricType+"/"+name],
+			})
+		}
+	}
+
+	// Extract conditions, which explain why the HPA is or isn't scaling
+	if conditions, found, _ := unstructured.NestedSlice(item.Object, "status", "conditions"); found {
+		for _, c := range conditions {
+			condMap, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			cond := HPACondition{}
+			cond.Type, _, _ = unstructured.NestedString(condMap, "type")
+			cond.Status, _, _ = unstructured.NestedString(condMap, "status")
+			cond.Reason, _, _ = unstructured.NestedString(condMap, "reason")
+			cond.Message, _, _ = unstructured.NestedString(condMap, "message")
+			hpa.Conditions = append(hpa.Conditions, cond)
+		}
+	}
+
+	return hpa
+}
+
+// hpaMetricSource returns a v2 metric's type, display name, and the type-specific source
+// block holding its target (in spec) or current value (in status)
+func hpaMetricSource(metric map[string]any) (string, string, map[string]any) {
+	metricType, _, _ := unstructured.NestedString(metric, "type")
+
+	switch metricType {
+	case "Resource":
+		source, _, _ := unstructured.NestedMap(metric, "resource")
+		name, _, _ := unstructured.NestedString(source, "name")
+		return metricType, name, source
+	case "ContainerResource":
+		source, _, _ := unstructured.NestedMap(metric, "containerResource")
+		name, _, _ := unstructured.NestedString(source, "name")
+		container, _, _ := unstructured.NestedString(source, "container")
+		return metricType, fmt.Sprintf("%s (container %s)", name, container), source
+	case "Pods":
+		source, _, _ := unstructured.NestedMap(metric, "pods")
+		name, _, _ := unstructured.NestedString(source, "metric", "name")
+		return metricType, name, source
+	case "Object":
+		source, _, _ := unstructured.NestedMap(metric, "object")
+		name, _, _ := unstructured.NestedString(source, "metric", "name")
+		return metricType, name, source
+	case "External":
+		source, _, _ := unstructured.NestedMap(metric, "external")
+		name, _, _ := unstructured.NestedString(source, "metric", "name")
+		return metricType, name, source
+	}
+	return metricType, "", nil
+}
+
+// formatHPAMetricValue formats the target or current block of a metric source, preferring
+// utilization percentages over average and absolute values like kubectl does
+func formatHPAMetricValue(source map[string]any, field string) string {
+	if utilization, found, _ := unstructured.NestedInt64(source, field, "averageUtilization"); found {
+		return fmt.Sprintf("%d%%", utilization)
+	}
+	if averageValue, found, _ := unstructured.NestedString(source, field, "averageValue"); found {
+		return averageValue
+	}
+	if value, found, _ := unstructured.NestedString(source, field, "value"); found {
+		return value
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapHorizontalPodAutoscalerResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling/v2",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]any{"name": "web", "namespace": "default"},
+		"spec": map[string]any{
+			"scaleTargetRef": map[string]any{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"},
+			"maxReplicas":    int64(10),
+			"metrics": []any{
+				map[string]any{
+					"type":     "Resource",
+					"resource": map[string]any{"name": "cpu", "target": map[string]any{"type": "Utilization", "averageUtilization": int64(80)}},
+				},
+				map[string]any{
+					"type": "Pods",
+					"pods": map[string]any{
+						"metric": map[string]any{"name": "http_requests_per_second"},
+						"target": map[string]any{"type": "AverageValue", "averageValue": "100"},
+					},
+				},
+			},
+		},
+		"status": map[string]any{
+			"currentReplicas": int64(2),
+			"desiredReplicas": int64(3),
+			"currentMetrics": []any{
+				map[string]any{
+					"type": "Pods",
+					"pods": map[string]any{
+						"metric":  map[string]any{"name": "http_requests_per_second"},
+						"current": map[string]any{"averageValue": "150"},
+					},
+				},
+				map[string]any{
+					"type":     "Resource",
+					"resource": map[string]any{"name": "cpu", "current": map[string]any{"averageUtilization": int64(45), "averageValue": "90m"}},
+				},
+			},
+			"conditions": []any{
+				map[string]any{"type": "ScalingActive", "status": "True", "reason": "ValidMetricFound", "message": "the HPA was able to compute the replica count"},
+			},
+		},
+	}}
+
+	got, ok := mapHorizontalPodAutoscalerResource(item).(HorizontalPodAutoscalerListContent)
+	if !ok {
+		t.Fatalf("expected HorizontalPodAutoscalerListContent, got %T", mapHorizontalPodAutoscalerResource(item))
+	}
+	want := HorizontalPodAutoscalerListContent{
+		Name:            "web",
+		Namespace:       "default",
+		Target:          "Deployment/web",
+		MinReplicas:     1,
+		MaxReplicas:     10,
+		CurrentReplicas: 2,
+		DesiredReplicas: 3,
+		Metrics: []HPAMetric{
+			{Type: "Resource", Name: "cpu", Target: "80%", Current: "45%"},
+			{Type: "Pods", Name: "http_requests_per_second", Target: "100", Current: "150"},
+		},
+		Conditions: []HPACondition{
+			{Type: "ScalingActive", Status: "True", Reason: "ValidMetricFound", Message: "the HPA was able to compute the replica count"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestMapHorizontalPodAutoscalerResourceV1(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]any{"name": "web"},
+		"spec": map[string]any{
+			"minReplicas":                    int64(2),
+			"maxReplicas":                    int64(5),
+			"targetCPUUtilizationPercentage": int64(70),
+		},
+		"status": map[string]any{"currentCPUUtilizationPercentage": int64(95)},
+	}}
+
+	got := mapHorizontalPodAutoscalerResource(item).(HorizontalPodAutoscalerListContent)
+	if got.MinReplicas != 2 || got.MaxReplicas != 5 {
+		t.Errorf("MinReplicas/MaxReplicas = %d/%d, want 2/5", got.MinReplicas, got.MaxReplicas)
+	}
+	want := []HPAMetric{{Type: "Resource", Name: "cpu", Target: "70%", Current: "95%"}}
+	if !reflect.DeepEqual(got.Metrics, want) {
+		t.Errorf("Metrics = %+v, want %+v", got.Metrics, want)
+	}
+}
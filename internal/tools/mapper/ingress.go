@@ -9,13 +9,21 @@ import (
 
 // IngressListContent represents Ingress-specific fields for list display
 type IngressListContent struct {
-	Name      string   `json:"name"`
-	Namespace string   `json:"namespace,omitempty"`
-	Class     string   `json:"class,omitempty"`
-	Hosts     []string `json:"hosts,omitempty"`
-	Address   string   `json:"address,omitempty"`
-	Ports     string   `json:"ports,omitempty"`
-	Age       string   `json:"age,omitempty"`
+	Name      string              `json:"name"`
+	Namespace string              `json:"namespace,omitempty"`
+	Class     string              `json:"class,omitempty"`
+	Hosts     []string            `json:"hosts,omitempty"`
+	Paths     map[string][]string `json:"paths,omitempty"` // Paths by host ("*" for rules without a host)
+	TLS       []IngressTLSContent `json:"tls,omitempty"`
+	Address   string              `json:"address,omitempty"`
+	Ports     string              `json:"ports,omitempty"`
+	Age       string              `json:"age,omitempty"`
+}
+
+// IngressTLSContent represents a TLS entry of an Ingress
+type IngressTLSContent struct {
+	Hosts      []string `json:"hosts,omitempty"`
+	SecretName string   `json:"secretName,omitempty"`
 }
 
 func init() {
@@ -37,13 +45,51 @@ func mapIngressResource(item unstructured.Unstructured) any {
 		ingress.Class = ingressClass
 	}
 
-	// Extract hosts from rules
+	// Extract hosts and paths from rules
+	hasRules := false
 	if rules, found, _ := unstructured.NestedSlice(item.Object, "spec", "rules"); found {
+		hasRules = len(rules) > 0
 		for _, rule := range rules {
 			if ruleMap, ok := rule.(map[string]any); ok {
-				if host, found, _ := unstructured.NestedString(ruleMap, "host"); found && host != "" {
+				host, _, _ := unstructured.NestedString(ruleMap, "host")
+				if host != "" {
 					ingress.Hosts = append(ingress.Hosts, host)
 				}
+
+				if paths, found, _ := unstructured.NestedSlice(ruleMap, "http", "paths"); found {
+					pathKey := host
+					if pathKey == "" {
+						pathKey = "*"
+					}
+					for _, p := range paths {
+						if pathMap, ok := p.(map[string]any); ok {
+							path, _, _ := unstructured.NestedString(pathMap, "path")
+							if path == "" {
+								path = "/"
+							}
+							if ingress.Paths == nil {
+								ingress.Paths = make(map[string][]string)
+							}
+							ingress.Paths[pathKey] = append(ingress.Paths[pathKey], path)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Extract TLS configuration
+	if tls, found, _ := unstructured.NestedSlice(item.Object, "spec", "tls"); found {
+		for _, t := range tls {
+			if tlsMap, ok := t.(map[string]any); ok {
+				tlsContent := IngressTLSContent{}
+				if hosts, found, _ := unstructured.NestedStringSlice(tlsMap, "hosts"); found {
+					tlsContent.Hosts = hosts
+				}
+				if secretName, found, _ := unstructured.NestedString(tlsMap, "secretName"); found {
+					tlsContent.SecretName = secretName
+				}
+				ingress.TLS = append(ingress.TLS, tlsContent)
 			}
 		}
 	}
@@ -66,8 +112,15 @@ func mapIngressResource(item unstructured.Unstructured) any {
 		}
 	}
 
-	// Default ports for Ingress
-	ingress.Ports = "80,443"
+	// Derive ports from the routing configuration (like kubectl)
+	var ports []string
+	if _, hasDefaultBackend, _ := unstructured.NestedMap(item.Object, "spec", "defaultBackend"); hasRules || hasDefaultBackend {
+		ports = append(ports, "80")
+	}
+	if len(ingress.TLS) > 0 {
+		ports = append(ports, "443")
+	}
+	ingress.Ports = strings.Join(ports, ",")
 
 	// TODO: Calculate age from creation timestamp
 
@@ -0,0 +1,77 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapIngressResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          map[string]any
+		expectedPorts string
+		expectedPaths map[string][]string
+	}{
+		{
+			name: "plain rules only",
+			spec: map[string]any{
+				"rules": []any{
+					map[string]any{
+						"host": "example.com",
+						"http": map[string]any{
+							"paths": []any{
+								map[string]any{"path": "/api"},
+								map[string]any{"path": "/web"},
+							},
+						},
+					},
+				},
+			},
+			expectedPorts: "80",
+			expectedPaths: map[string][]string{"example.com": {"/api", "/web"}},
+		},
+		{
+			name: "rules with TLS and no host",
+			spec: map[string]any{
+				"rules": []any{
+					map[string]any{
+						"http": map[string]any{
+							"paths": []any{
+								map[string]any{"pathType": "Prefix"},
+							},
+						},
+					},
+				},
+				"tls": []any{
+					map[string]any{"hosts": []any{"example.com"}, "secretName": "example-tls"},
+				},
+			},
+			expectedPorts: "80,443",
+			expectedPaths: map[string][]string{"*": {"/"}},
+		},
+		{
+			name:          "no rules or TLS",
+			spec:          map[string]any{},
+			expectedPorts: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "test", "namespace": "default"},
+				"spec":     tt.spec,
+			}}
+
+			ingress := mapIngressResource(item).(IngressListContent)
+			if ingress.Ports != tt.expectedPorts {
+				t.Errorf("Ports = %q, want %q", ingress.Ports, tt.expectedPorts)
+			}
+			if !reflect.DeepEqual(ingress.Paths, tt.expectedPaths) {
+				t.Errorf("Paths = %v, want %v", ingress.Paths, tt.expectedPaths)
+			}
+		})
+	}
+}
@@ -22,6 +22,16 @@ func TestAllResourceMappersRegistered(t *testing.T) {
 		{Group: "events.k8s.io", Version: "v1beta1", Kind: "Event"},
 		{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"},
 		{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"},
+		{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+		{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+		{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"},
+		{Group: "discovery.k8s.io", Version: "v1", Kind: "EndpointSlice"},
+		{Group: "", Version: "v1", Kind: "ServiceAccount"},
+		{Group: "autoscaling.k8s.io", Version: "v1", Kind: "VerticalPodAutoscaler"},
+		{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+		{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+		{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+		{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"},
 	}
 
 	for _, gvk := range expectedMappers {
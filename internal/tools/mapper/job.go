@@ -9,11 +9,16 @@ import (
 
 // JobListContent represents Job-specific fields for list display
 type JobListContent struct {
-	Name        string `json:"name"`
-	Namespace   string `json:"namespace,omitempty"`
-	Completions string `json:"completions,omitempty"`
-	Duration    string `json:"duration,omitempty"`
-	Age         string `json:"age,omitempty"`
+	Name        string              `json:"name"`
+	Namespace   string              `json:"namespace,omitempty"`
+	Completions string              `json:"completions,omitempty"`
+	Active      int64               `json:"active,omitempty"`
+	Suspended   bool                `json:"suspended,omitempty"`
+	CronJob     string              `json:"cronJob,omitempty"` // Owning CronJob, if any
+	Owner       string              `json:"owner,omitempty"`   // Controlling owner as Kind/name
+	Duration    string              `json:"duration,omitempty"`
+	Age         string              `json:"age,omitempty"`
+	Template    *PodTemplateSummary `json:"template,omitempty"`
 }
 
 func init() {
@@ -28,6 +33,7 @@ func mapJobResource(item unstructured.Unstructured) any {
 	job := JobListContent{
 		Name:      item.GetName(),
 		Namespace: item.GetNamespace(),
+		Owner:     ownerReference(item),
 	}
 
 	// Extract Job completion status
@@ -52,6 +58,22 @@ func mapJobResource(item unstructured.Unstructured) any {
 		job.Completions += fmt.Sprintf(" (%d failed)", failed)
 	}
 
+	if active, found, _ := unstructured.NestedInt64(item.Object, "status", "active"); found {
+		job.Active = active
+	}
+
+	if suspend, found, _ := unstructured.NestedBool(item.Object, "spec", "suspend"); found {
+		job.Suspended = suspend
+	}
+
+	// Link to the owning CronJob
+	for _, owner := range item.GetOwnerReferences() {
+		if owner.Kind == "CronJob" {
+			job.CronJob = owner.Name
+			break
+		}
+	}
+
 	// Calculate duration if job has started and completed
 	if startTime, found, _ := unstructured.NestedString(item.Object, "status", "startTime"); found && startTime != "" {
 		if completionTime, found, _ := unstructured.NestedString(item.Object, "status", "completionTime"); found && completionTime != "" {
@@ -63,6 +85,9 @@ func mapJobResource(item unstructured.Unstructured) any {
 		}
 	}
 
+	// Summarize the pod template (containers, images and aggregate resources)
+	job.Template = summarizePodTemplate(item, "spec", "template", "spec")
+
 	// TODO: Calculate age from creation timestamp
 
 	return job
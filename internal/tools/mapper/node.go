@@ -3,6 +3,7 @@ package mapper
 import (
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -19,6 +20,12 @@ type NodeListContent struct {
 	OSImage          string   `json:"osImage,omitempty"`
 	KernelVersion    string   `json:"kernelVersion,omitempty"`
 	ContainerRuntime string   `json:"containerRuntime,omitempty"`
+
+	CPUCapacityMillicores    int64 `json:"cpuCapacityMillicores,omitempty"`
+	CPUAllocatableMillicores int64 `json:"cpuAllocatableMillicores,omitempty"`
+	MemoryCapacityMiB        int64 `json:"memoryCapacityMiB,omitempty"`
+	MemoryAllocatableMiB     int64 `json:"memoryAllocatableMiB,omitempty"`
+	PodsAllocatable          int64 `json:"podsAllocatable,omitempty"`
 }
 
 func init() {
@@ -105,6 +112,29 @@ func mapNodeResource(item unstructured.Unstructured) any {
 		node.ContainerRuntime = containerRuntime
 	}
 
+	// Extract capacity and allocatable resources
+	if cpu, found, _ := unstructured.NestedString(item.Object, "status", "capacity", "cpu"); found {
+		node.CPUCapacityMillicores = parseCPUToMillicores(cpu)
+	}
+
+	if cpu, found, _ := unstructured.NestedString(item.Object, "status", "allocatable", "cpu"); found {
+		node.CPUAllocatableMillicores = parseCPUToMillicores(cpu)
+	}
+
+	if memory, found, _ := unstructured.NestedString(item.Object, "status", "capacity", "memory"); found {
+		node.MemoryCapacityMiB = parseMemoryToMiB(memory)
+	}
+
+	if memory, found, _ := unstructured.NestedString(item.Object, "status", "allocatable", "memory"); found {
+		node.MemoryAllocatableMiB = parseMemoryToMiB(memory)
+	}
+
+	if pods, found, _ := unstructured.NestedString(item.Object, "status", "allocatable", "pods"); found {
+		if quantity, err := resource.ParseQuantity(pods); err == nil {
+			node.PodsAllocatable = quantity.Value()
+		}
+	}
+
 	// TODO: Calculate age from creation timestamp
 
 	return node
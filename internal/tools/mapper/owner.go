@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ownerReference returns the resource's owner as "Kind/name" (e.g. "ReplicaSet/web-5d4f8"),
+// preferring the controller reference and falling back to the first owner. Returns an empty
+// string for unowned resources.
+func ownerReference(item unstructured.Unstructured) string {
+	owners := item.GetOwnerReferences()
+	if len(owners) == 0 {
+		return ""
+	}
+
+	owner := owners[0]
+	for _, ref := range owners {
+		if ref.Controller != nil && *ref.Controller {
+			owner = ref
+			break
+		}
+	}
+	return owner.Kind + "/" + owner.Name
+}
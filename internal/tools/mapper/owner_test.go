@@ -0,0 +1,43 @@
+package mapper
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOwnerReference(t *testing.T) {
+	isController := true
+	tests := []struct {
+		name   string
+		owners []metav1.OwnerReference
+		want   string
+	}{
+		{name: "unowned", want: ""},
+		{
+			name:   "single owner",
+			owners: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-5d4f8"}},
+			want:   "ReplicaSet/web-5d4f8",
+		},
+		{
+			name: "prefers controller",
+			owners: []metav1.OwnerReference{
+				{Kind: "ConfigMap", Name: "settings"},
+				{Kind: "Deployment", Name: "web", Controller: &isController},
+			},
+			want: "Deployment/web",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := unstructured.Unstructured{Object: map[string]any{}}
+			item.SetOwnerReferences(tt.owners)
+
+			if got := ownerReference(item); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -2,75 +2,75 @@ package mapper
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // PodListContent represents Pod-specific fields for list display
 type PodListContent struct {
-	Name                  string `json:"name"`
-	Namespace             string `json:"namespace,omitempty"`
-	Status                string `json:"status,omitempty"`
-	Ready                 string `json:"ready,omitempty"`
-	Restarts              int64  `json:"restarts,omitempty"`
-	Age                   string `json:"age,omitempty"`
-	MemoryRequestMiB      int64  `json:"memoryRequestMiB,omitempty"`
-	MemoryLimitMiB        int64  `json:"memoryLimitMiB,omitempty"`
-	OOMKills              int64  `json:"oomKills,omitempty"`
-	LastTerminationReason string `json:"lastTerminationReason,omitempty"`
+	Name                  string                     `json:"name"`
+	Namespace             string                     `json:"namespace,omitempty"`
+	Status                string                     `json:"status,omitempty"`
+	Ready                 string                     `json:"ready,omitempty"`
+	Started               string                     `json:"started,omitempty"` // Containers whose startup probe (if any) has passed
+	Restarts              int64                      `json:"restarts,omitempty"`
+	Age                   string                     `json:"age,omitempty"`
+	MemoryRequestMiB      int64                      `json:"memoryRequestMiB,omitempty"`
+	MemoryLimitMiB        int64                      `json:"memoryLimitMiB,omitempty"`
+	OOMKills              int64                      `json:"oomKills,omitempty"`
+	LastTerminationReason string                     `json:"lastTerminationReason,omitempty"`
+	LastRestartAt         string                     `json:"lastRestartAt,omitempty"`       // Most recent container termination that led to a restart (RFC3339)
+	Owner                 string                     `json:"owner,omitempty"`               // Controlling owner as Kind/name, e.g. ReplicaSet/web-5d4f8
+	NotReadyContainers    []ContainerStateStatus     `json:"notReadyContainers,omitempty"`  // Only containers that aren't ready, to keep output compact
+	EphemeralContainers   []EphemeralContainerStatus `json:"ephemeralContainers,omitempty"` // Debug containers added with kubectl debug
+}
+
+// ContainerStateStatus summarizes the current state of a regular container
+type ContainerStateStatus struct {
+	Name  string `json:"name"`
+	State string `json:"state,omitempty"` // Running, Waiting: <reason> (e.g. CrashLoopBackOff), or Terminated: <reason>
+}
+
+// EphemeralContainerStatus summarizes an ephemeral (debug) container and its current state
+type EphemeralContainerStatus struct {
+	Name            string `json:"name"`
+	TargetContainer string `json:"targetContainer,omitempty"` // Container whose process namespace it shares
+	State           string `json:"state,omitempty"`           // Running, Waiting: <reason>, or Terminated: <reason>
 }
 
 // parseMemoryToMiB converts Kubernetes memory strings to MiB
-// Supports formats like: "128Mi", "1Gi", "512000000", "1000000k", etc.
+// Supports any Kubernetes quantity format: "128Mi", "1Gi", "512000000", "1e6", "1000k", etc.
 func parseMemoryToMiB(memoryStr string) int64 {
 	if memoryStr == "" {
 		return 0
 	}
 
-	// Define conversion ratios to MiB
-	units := map[string]int64{
-		"":   1024 * 1024, // bytes to MiB
-		"k":  1024,        // kilobytes to MiB
-		"Ki": 1024,        // kibibytes to MiB
-		"M":  1,           // megabytes to MiB (approximately)
-		"Mi": 1,           // mebibytes to MiB
-		"G":  1024,        // gigabytes to MiB (approximately)
-		"Gi": 1024,        // gibibytes to MiB
-		"T":  1024 * 1024, // terabytes to MiB (approximately)
-		"Ti": 1024 * 1024, // tebibytes to MiB
+	quantity, err := resource.ParseQuantity(strings.TrimSpace(memoryStr))
+	if err != nil {
+		return 0
 	}
 
-	// Use regex to parse number and unit
-	re := regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([A-Za-z]*)$`)
-	matches := re.FindStringSubmatch(strings.TrimSpace(memoryStr))
+	// Convert bytes to MiB
+	return quantity.Value() / (1024 * 1024)
+}
 
-	if len(matches) != 3 {
+// parseCPUToMillicores converts Kubernetes CPU strings to millicores
+// Supports any Kubernetes quantity format: "500m", "2", "0.5", etc.
+func parseCPUToMillicores(cpuStr string) int64 {
+	if cpuStr == "" {
 		return 0
 	}
 
-	value, err := strconv.ParseFloat(matches[1], 64)
+	quantity, err := resource.ParseQuantity(strings.TrimSpace(cpuStr))
 	if err != nil {
 		return 0
 	}
 
-	unit := matches[2]
-	multiplier, exists := units[unit]
-	if !exists {
-		return 0
-	}
-
-	// Convert to MiB
-	if unit == "" || unit == "k" || unit == "Ki" {
-		// Convert from smaller units
-		return int64(value / float64(multiplier))
-	} else {
-		// Convert from larger units
-		return int64(value * float64(multiplier))
-	}
+	return quantity.MilliValue()
 }
 
 func init() {
@@ -85,6 +85,7 @@ func mapPodResource(item unstructured.Unstructured) any {
 	pod := PodListContent{
 		Name:      item.GetName(),
 		Namespace: item.GetNamespace(),
+		Owner:     ownerReference(item),
 	}
 
 	// Extract Pod-specific fields
@@ -93,38 +94,34 @@ func mapPodResource(item unstructured.Unstructured) any {
 	}
 
 	// Extract memory resources from container specs
-	if containers, found, _ := unstructured.NestedSlice(item.Object, "spec", "containers"); found {
-		var totalMemoryRequest, totalMemoryLimit int64
-
-		for _, c := range containers {
-			if containerMap, ok := c.(map[string]any); ok {
-				// Extract memory request
-				if memReq, found, _ := unstructured.NestedString(containerMap, "resources", "requests", "memory"); found {
-					totalMemoryRequest += parseMemoryToMiB(memReq)
-				}
-				// Extract memory limit
-				if memLimit, found, _ := unstructured.NestedString(containerMap, "resources", "limits", "memory"); found {
-					totalMemoryLimit += parseMemoryToMiB(memLimit)
-				}
-			}
-		}
-
-		pod.MemoryRequestMiB = totalMemoryRequest
-		pod.MemoryLimitMiB = totalMemoryLimit
+	if summary := summarizePodTemplate(item, "spec"); summary != nil {
+		pod.MemoryRequestMiB = summary.MemoryRequestMiB
+		pod.MemoryLimitMiB = summary.MemoryLimitMiB
 	}
 
 	// Extract container statuses for ready count, restarts, and OOM kills
 	if containers, found, _ := unstructured.NestedSlice(item.Object, "status", "containerStatuses"); found {
 		ready := 0
+		started := 0
 		total := len(containers)
 		restarts := int64(0)
 		oomKills := int64(0)
 		var lastTerminationReason string
+		var lastRestartAt time.Time
 
 		for _, c := range containers {
 			if containerMap, ok := c.(map[string]any); ok {
 				if r, found, _ := unstructured.NestedBool(containerMap, "ready"); found && r {
 					ready++
+				} else {
+					name, _, _ := unstructured.NestedString(containerMap, "name")
+					pod.NotReadyContainers = append(pod.NotReadyContainers, ContainerStateStatus{
+						Name:  name,
+						State: containerStateSummary(containerMap),
+					})
+				}
+				if s, found, _ := unstructured.NestedBool(containerMap, "started"); found && s {
+					started++
 				}
 				if rc, found, _ := unstructured.NestedInt64(containerMap, "restartCount"); found {
 					restarts += rc
@@ -139,6 +136,11 @@ func mapPodResource(item unstructured.Unstructured) any {
 								oomKills++
 							}
 						}
+						if finishedAt, found, _ := unstructured.NestedString(terminated, "finishedAt"); found {
+							if parsed, err := time.Parse(time.RFC3339, finishedAt); err == nil && parsed.After(lastRestartAt) {
+								lastRestartAt = parsed
+							}
+						}
 					}
 				}
 
@@ -156,12 +158,75 @@ func mapPodResource(item unstructured.Unstructured) any {
 		}
 
 		pod.Ready = fmt.Sprintf("%d/%d", ready, total)
+		pod.Started = fmt.Sprintf("%d/%d", started, total)
 		pod.Restarts = restarts
 		pod.OOMKills = oomKills
 		pod.LastTerminationReason = lastTerminationReason
+		if !lastRestartAt.IsZero() {
+			pod.LastRestartAt = lastRestartAt.UTC().Format(time.RFC3339)
+		}
 	}
 
+	pod.EphemeralContainers = mapEphemeralContainers(item)
+
 	// TODO: Calculate age from creation timestamp
 
 	return pod
 }
+
+// mapEphemeralContainers combines the ephemeral containers in the pod spec with their statuses,
+// so debug containers that haven't started yet are still listed
+func mapEphemeralContainers(item unstructured.Unstructured) []EphemeralContainerStatus {
+	specs, _, _ := unstructured.NestedSlice(item.Object, "spec", "ephemeralContainers")
+	statuses, _, _ := unstructured.NestedSlice(item.Object, "status", "ephemeralContainerStatuses")
+	if len(specs) == 0 && len(statuses) == 0 {
+		return nil
+	}
+
+	states := map[string]string{}
+	for _, s := range statuses {
+		if statusMap, ok := s.(map[string]any); ok {
+			name, _, _ := unstructured.NestedString(statusMap, "name")
+			states[name] = containerStateSummary(statusMap)
+		}
+	}
+
+	var containers []EphemeralContainerStatus
+	seen := map[string]bool{}
+	for _, c := range specs {
+		if containerMap, ok := c.(map[string]any); ok {
+			name, _, _ := unstructured.NestedString(containerMap, "name")
+			target, _, _ := unstructured.NestedString(containerMap, "targetContainerName")
+			containers = append(containers, EphemeralContainerStatus{Name: name, TargetContainer: target, State: states[name]})
+			seen[name] = true
+		}
+	}
+	for _, s := range statuses {
+		if statusMap, ok := s.(map[string]any); ok {
+			if name, _, _ := unstructured.NestedString(statusMap, "name"); !seen[name] {
+				containers = append(containers, EphemeralContainerStatus{Name: name, State: states[name]})
+			}
+		}
+	}
+	return containers
+}
+
+// containerStateSummary formats a container status's current state like kubectl describe
+func containerStateSummary(status map[string]any) string {
+	if _, found, _ := unstructured.NestedMap(status, "state", "running"); found {
+		return "Running"
+	}
+	if waiting, found, _ := unstructured.NestedMap(status, "state", "waiting"); found {
+		if reason, _, _ := unstructured.NestedString(waiting, "reason"); reason != "" {
+			return "Waiting: " + reason
+		}
+		return "Waiting"
+	}
+	if terminated, found, _ := unstructured.NestedMap(status, "state", "terminated"); found {
+		if reason, _, _ := unstructured.NestedString(terminated, "reason"); reason != "" {
+			return "Terminated: " + reason
+		}
+		return "Terminated"
+	}
+	return ""
+}
@@ -1,7 +1,10 @@
 package mapper
 
 import (
+	"reflect"
 	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestParseMemoryToMiB(t *testing.T) {
@@ -19,7 +22,14 @@ func TestParseMemoryToMiB(t *testing.T) {
 		{"1000Mi", 1000},
 		{"1.5Gi", 1536}, // 1.5 * 1024 = 1536 MiB
 		{"invalid", 0},
-		{"123", 0}, // Raw number without unit treated as bytes, very small result
+		{"123", 0},                  // Raw number without unit treated as bytes, very small result
+		{"2G", 1907},                // 2,000,000,000 bytes = ~1907 MiB
+		{"1000000k", 953},           // 1,000,000,000 bytes = ~953 MiB
+		{"1e9", 953},                // Scientific notation
+		{"1E9", 953},                // Uppercase exponent
+		{"1Ti", 1024 * 1024},        // Tebibytes
+		{"1Pi", 1024 * 1024 * 1024}, // Pebibytes
+		{"100m", 0},                 // Milli-bytes, rounds to nothing
 	}
 
 	for _, test := range tests {
@@ -29,3 +39,65 @@ func TestParseMemoryToMiB(t *testing.T) {
 		}
 	}
 }
+
+func TestMapEphemeralContainers(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "web-1", "namespace": "default"},
+		"spec": map[string]any{
+			"containers": []any{map[string]any{"name": "app"}},
+			"ephemeralContainers": []any{
+				map[string]any{"name": "debugger-a", "targetContainerName": "app"},
+				map[string]any{"name": "debugger-b"},
+			},
+		},
+		"status": map[string]any{
+			"ephemeralContainerStatuses": []any{
+				map[string]any{"name": "debugger-a", "state": map[string]any{"running": map[string]any{}}},
+				map[string]any{"name": "debugger-b", "state": map[string]any{"terminated": map[string]any{"reason": "Completed"}}},
+			},
+		},
+	}}
+
+	pod := mapPodResource(item).(PodListContent)
+	want := []EphemeralContainerStatus{
+		{Name: "debugger-a", TargetContainer: "app", State: "Running"},
+		{Name: "debugger-b", State: "Terminated: Completed"},
+	}
+	if !reflect.DeepEqual(pod.EphemeralContainers, want) {
+		t.Errorf("EphemeralContainers = %+v, want %+v", pod.EphemeralContainers, want)
+	}
+}
+
+func TestMapPodContainerStates(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "web-1", "namespace": "default"},
+		"status": map[string]any{
+			"phase": "Running",
+			"containerStatuses": []any{
+				map[string]any{"name": "app", "ready": true, "started": true, "state": map[string]any{"running": map[string]any{}}},
+				map[string]any{"name": "sidecar", "ready": false, "started": false, "restartCount": int64(4),
+					"state":     map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}},
+					"lastState": map[string]any{"terminated": map[string]any{"reason": "Error", "finishedAt": "2025-06-01T10:04:00Z"}}},
+				map[string]any{"name": "warming", "ready": false, "started": true, "state": map[string]any{"running": map[string]any{}}},
+			},
+		},
+	}}
+
+	pod := mapPodResource(item).(PodListContent)
+	if pod.Ready != "1/3" {
+		t.Errorf("Ready = %q, want %q", pod.Ready, "1/3")
+	}
+	if pod.Started != "2/3" {
+		t.Errorf("Started = %q, want %q", pod.Started, "2/3")
+	}
+	if pod.LastRestartAt != "2025-06-01T10:04:00Z" {
+		t.Errorf("LastRestartAt = %q, want %q", pod.LastRestartAt, "2025-06-01T10:04:00Z")
+	}
+	want := []ContainerStateStatus{
+		{Name: "sidecar", State: "Waiting: CrashLoopBackOff"},
+		{Name: "warming", State: "Running"},
+	}
+	if !reflect.DeepEqual(pod.NotReadyContainers, want) {
+		t.Errorf("NotReadyContainers = %+v, want %+v", pod.NotReadyContainers, want)
+	}
+}
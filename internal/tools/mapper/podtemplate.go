@@ -0,0 +1,103 @@
+package mapper
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PodTemplateSummary is a compact view of a pod spec shared by the Pod and workload mappers
+type PodTemplateSummary struct {
+	Containers           []PodTemplateContainer `json:"containers,omitempty"`
+	CPURequestMillicores int64                  `json:"cpuRequestMillicores,omitempty"`
+	CPULimitMillicores   int64                  `json:"cpuLimitMillicores,omitempty"`
+	MemoryRequestMiB     int64                  `json:"memoryRequestMiB,omitempty"`
+	MemoryLimitMiB       int64                  `json:"memoryLimitMiB,omitempty"`
+}
+
+// PodTemplateContainer identifies a container, its image and the ports it exposes
+type PodTemplateContainer struct {
+	Name            string                     `json:"name"`
+	Image           string                     `json:"image,omitempty"`
+	ImagePullPolicy string                     `json:"imagePullPolicy,omitempty"` // Always, IfNotPresent, or Never
+	Ports           []PodTemplateContainerPort `json:"ports,omitempty"`
+}
+
+// PodTemplateContainerPort is a port declared by a container
+type PodTemplateContainerPort struct {
+	ContainerPort int64  `json:"containerPort"`
+	Name          string `json:"name,omitempty"`
+	Protocol      string `json:"protocol,omitempty"` // TCP, UDP, or SCTP
+}
+
+// summarizePodSpec extracts container names, images, pull policies, ports and aggregate requests/limits from a
+// pod spec. Init containers are not included. Returns nil if the spec has no containers.
+func summarizePodSpec(podSpec map[string]any) *PodTemplateSummary {
+	containers, found, _ := unstructured.NestedSlice(podSpec, "containers")
+	if !found || len(containers) == 0 {
+		return nil
+	}
+
+	summary := &PodTemplateSummary{}
+	for _, c := range containers {
+		containerMap, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := unstructured.NestedString(containerMap, "name")
+		image, _, _ := unstructured.NestedString(containerMap, "image")
+		imagePullPolicy, _, _ := unstructured.NestedString(containerMap, "imagePullPolicy")
+		summary.Containers = append(summary.Containers, PodTemplateContainer{
+			Name:            name,
+			Image:           image,
+			ImagePullPolicy: imagePullPolicy,
+			Ports:           containerPorts(containerMap),
+		})
+
+		if cpuReq, found, _ := unstructured.NestedString(containerMap, "resources", "requests", "cpu"); found {
+			summary.CPURequestMillicores += parseCPUToMillicores(cpuReq)
+		}
+		if cpuLimit, found, _ := unstructured.NestedString(containerMap, "resources", "limits", "cpu"); found {
+			summary.CPULimitMillicores += parseCPUToMillicores(cpuLimit)
+		}
+		if memReq, found, _ := unstructured.NestedString(containerMap, "resources", "requests", "memory"); found {
+			summary.MemoryRequestMiB += parseMemoryToMiB(memReq)
+		}
+		if memLimit, found, _ := unstructured.NestedString(containerMap, "resources", "limits", "memory"); found {
+			summary.MemoryLimitMiB += parseMemoryToMiB(memLimit)
+		}
+	}
+
+	return summary
+}
+
+// summarizePodTemplate summarizes the pod template found at the given path to its spec,
+// e.g. "spec", "template", "spec" for Deployments
+func summarizePodTemplate(item unstructured.Unstructured, specPath ...string) *PodTemplateSummary {
+	podSpec, found, _ := unstructured.NestedMap(item.Object, specPath...)
+	if !found {
+		return nil
+	}
+	return summarizePodSpec(podSpec)
+}
+
+// containerPorts extracts the ports declared by a container
+func containerPorts(container map[string]any) []PodTemplateContainerPort {
+	ports, found, _ := unstructured.NestedSlice(container, "ports")
+	if !found {
+		return nil
+	}
+
+	var result []PodTemplateContainerPort
+	for _, p := range ports {
+		portMap, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		port := PodTemplateContainerPort{}
+		port.ContainerPort, _, _ = unstructured.NestedInt64(portMap, "containerPort")
+		port.Name, _, _ = unstructured.NestedString(portMap, "name")
+		port.Protocol, _, _ = unstructured.NestedString(portMap, "protocol")
+		result = append(result, port)
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSummarizePodTemplate(t *testing.T) {
+	podSpec := map[string]any{
+		"containers": []any{
+			map[string]any{
+				"name":            "app",
+				"image":           "example/app:1.0",
+				"imagePullPolicy": "IfNotPresent",
+				"ports": []any{
+					map[string]any{"containerPort": int64(8080), "name": "http", "protocol": "TCP"},
+					map[string]any{"containerPort": int64(9090), "name": "metrics", "protocol": "TCP"},
+				},
+				"resources": map[string]any{
+					"requests": map[string]any{"cpu": "250m", "memory": "128Mi"},
+					"limits":   map[string]any{"cpu": "1", "memory": "256Mi"},
+				},
+			},
+			map[string]any{
+				"name":  "sidecar",
+				"image": "example/proxy:2.0",
+				"resources": map[string]any{
+					"requests": map[string]any{"cpu": "50m", "memory": "32Mi"},
+				},
+			},
+		},
+	}
+
+	t.Run("deployment template", func(t *testing.T) {
+		item := unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{"template": map[string]any{"spec": podSpec}},
+		}}
+
+		summary := summarizePodTemplate(item, "spec", "template", "spec")
+		if summary == nil {
+			t.Fatal("expected summary, got nil")
+		}
+		if len(summary.Containers) != 2 || summary.Containers[1].Image != "example/proxy:2.0" {
+			t.Errorf("unexpected containers: %+v", summary.Containers)
+		}
+		if app := summary.Containers[0]; app.ImagePullPolicy != "IfNotPresent" || len(app.Ports) != 2 || app.Ports[0].ContainerPort != 8080 || app.Ports[1].Name != "metrics" {
+			t.Errorf("unexpected app container pull policy or ports: %+v", app)
+		}
+		if len(summary.Containers[1].Ports) != 0 {
+			t.Errorf("expected no ports for sidecar, got %+v", summary.Containers[1].Ports)
+		}
+		if summary.CPURequestMillicores != 300 || summary.CPULimitMillicores != 1000 {
+			t.Errorf("unexpected CPU totals: request=%d limit=%d", summary.CPURequestMillicores, summary.CPULimitMillicores)
+		}
+		if summary.MemoryRequestMiB != 160 || summary.MemoryLimitMiB != 256 {
+			t.Errorf("unexpected memory totals: request=%d limit=%d", summary.MemoryRequestMiB, summary.MemoryLimitMiB)
+		}
+	})
+
+	t.Run("cronjob template", func(t *testing.T) {
+		item := unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{"jobTemplate": map[string]any{"spec": map[string]any{"template": map[string]any{"spec": podSpec}}}},
+		}}
+
+		content, ok := mapCronJobResource(item).(CronJobListContent)
+		if !ok {
+			t.Fatal("expected CronJobListContent")
+		}
+		if content.Template == nil || len(content.Template.Containers) != 2 {
+			t.Errorf("expected CronJob template with 2 containers, got %+v", content.Template)
+		}
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		item := unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{}}}
+		if summary := summarizePodTemplate(item, "spec", "template", "spec"); summary != nil {
+			t.Errorf("expected nil summary, got %+v", summary)
+		}
+	})
+}
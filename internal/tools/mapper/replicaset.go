@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ReplicaSetListContent represents ReplicaSet-specific fields for list display
+type ReplicaSetListContent struct {
+	Name      string              `json:"name"`
+	Namespace string              `json:"namespace,omitempty"`
+	Desired   int64               `json:"desired"`
+	Ready     string              `json:"ready,omitempty"`
+	Available int64               `json:"available,omitempty"`
+	Owner     string              `json:"owner,omitempty"` // Owning Deployment as Kind/name, if any
+	Age       string              `json:"age,omitempty"`
+	Template  *PodTemplateSummary `json:"template,omitempty"`
+}
+
+func init() {
+	// Register ReplicaSet mapper
+	Register(
+		schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+		mapReplicaSetResource,
+	)
+}
+
+func mapReplicaSetResource(item unstructured.Unstructured) any {
+	replicaSet := ReplicaSetListContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+		Owner:     ownerReference(item),
+	}
+
+	if desired, found, _ := unstructured.NestedInt64(item.Object, "spec", "replicas"); found {
+		replicaSet.Desired = desired
+	}
+
+	// Extract ReplicaSet-specific fields from status
+	if replicas, found, _ := unstructured.NestedInt64(item.Object, "status", "replicas"); found {
+		readyReplicas, _, _ := unstructured.NestedInt64(item.Object, "status", "readyReplicas")
+		replicaSet.Ready = fmt.Sprintf("%d/%d", readyReplicas, replicas)
+	}
+
+	if available, found, _ := unstructured.NestedInt64(item.Object, "status", "availableReplicas"); found {
+		replicaSet.Available = available
+	}
+
+	// Summarize the pod template (containers, images and aggregate resources)
+	replicaSet.Template = summarizePodTemplate(item, "spec", "template", "spec")
+
+	// TODO: Calculate age from creation timestamp
+
+	return replicaSet
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ScaleContent represents the scale subresource of a workload (e.g. deployments/scale)
+type ScaleContent struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace,omitempty"`
+	DesiredReplicas int64  `json:"desiredReplicas"`
+	CurrentReplicas int64  `json:"currentReplicas"`
+	Selector        string `json:"selector,omitempty"` // Label selector in string form, as used by autoscalers
+}
+
+func init() {
+	// Register Scale mapper
+	Register(
+		schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+		mapScaleResource,
+	)
+}
+
+func mapScaleResource(item unstructured.Unstructured) any {
+	scale := ScaleContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+
+	if replicas, found, _ := unstructured.NestedInt64(item.Object, "spec", "replicas"); found {
+		scale.DesiredReplicas = replicas
+	}
+
+	if replicas, found, _ := unstructured.NestedInt64(item.Object, "status", "replicas"); found {
+		scale.CurrentReplicas = replicas
+	}
+
+	if selector, found, _ := unstructured.NestedString(item.Object, "status", "selector"); found {
+		scale.Selector = selector
+	}
+
+	return scale
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapScaleResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "Scale",
+		"metadata":   map[string]any{"name": "web", "namespace": "default"},
+		"spec":       map[string]any{"replicas": int64(5)},
+		"status":     map[string]any{"replicas": int64(3), "selector": "app=web"},
+	}}
+
+	got, ok := mapScaleResource(item).(ScaleContent)
+	if !ok {
+		t.Fatalf("expected ScaleContent, got %T", mapScaleResource(item))
+	}
+	want := ScaleContent{Name: "web", Namespace: "default", DesiredReplicas: 5, CurrentReplicas: 3, Selector: "app=web"}
+	if got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
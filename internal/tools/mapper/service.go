@@ -9,13 +9,16 @@ import (
 
 // ServiceListContent represents Service-specific fields for list display
 type ServiceListContent struct {
-	Name       string   `json:"name"`
-	Namespace  string   `json:"namespace,omitempty"`
-	Type       string   `json:"type,omitempty"`
-	ClusterIP  string   `json:"clusterIP,omitempty"`
-	ExternalIP []string `json:"externalIP,omitempty"`
-	Port       string   `json:"port,omitempty"`
-	Age        string   `json:"age,omitempty"`
+	Name         string            `json:"name"`
+	Namespace    string            `json:"namespace,omitempty"`
+	Type         string            `json:"type,omitempty"`
+	ClusterIP    string            `json:"clusterIP,omitempty"`
+	ExternalIP   []string          `json:"externalIP,omitempty"`
+	ExternalName string            `json:"externalName,omitempty"` // DNS name an ExternalName service aliases
+	Port         string            `json:"port,omitempty"`
+	Selector     map[string]string `json:"selector,omitempty"`
+	Hint         string            `json:"hint,omitempty"` // Explains routing for services without a pod selector
+	Age          string            `json:"age,omitempty"`
 }
 
 func init() {
@@ -63,6 +66,22 @@ func mapServiceResource(item unstructured.Unstructured) any {
 		}
 	}
 
+	if externalName, found, _ := unstructured.NestedString(item.Object, "spec", "externalName"); found {
+		service.ExternalName = externalName
+	}
+
+	if selector, found, _ := unstructured.NestedStringMap(item.Object, "spec", "selector"); found && len(selector) > 0 {
+		service.Selector = selector
+	}
+
+	// Services without a selector don't route to pods on their own, which is easy to miss
+	switch {
+	case service.Type == "ExternalName":
+		service.Hint = fmt.Sprintf("ExternalName service: resolves to %s via DNS CNAME, no endpoints or pods", service.ExternalName)
+	case service.Selector == nil:
+		service.Hint = "No selector: endpoints are not managed automatically and must be created manually (EndpointSlices/Endpoints)"
+	}
+
 	// TODO: Calculate age from creation timestamp
 
 	return service
@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapServiceResource(t *testing.T) {
+	newService := func(spec map[string]any) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "web", "namespace": "default"},
+			"spec":     spec,
+		}}
+	}
+
+	tests := []struct {
+		name             string
+		item             unstructured.Unstructured
+		wantSelector     map[string]string
+		wantExternalName string
+		wantHint         bool
+	}{
+		{
+			name:         "selector",
+			item:         newService(map[string]any{"type": "ClusterIP", "selector": map[string]any{"app": "web"}}),
+			wantSelector: map[string]string{"app": "web"},
+		},
+		{
+			name:     "no selector",
+			item:     newService(map[string]any{"type": "ClusterIP"}),
+			wantHint: true,
+		},
+		{
+			name:             "external name",
+			item:             newService(map[string]any{"type": "ExternalName", "externalName": "db.example.com"}),
+			wantExternalName: "db.example.com",
+			wantHint:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := mapServiceResource(tt.item).(ServiceListContent)
+			if !reflect.DeepEqual(service.Selector, tt.wantSelector) {
+				t.Errorf("Selector = %v, want %v", service.Selector, tt.wantSelector)
+			}
+			if service.ExternalName != tt.wantExternalName {
+				t.Errorf("ExternalName = %q, want %q", service.ExternalName, tt.wantExternalName)
+			}
+			if (service.Hint != "") != tt.wantHint {
+				t.Errorf("Hint = %q, want hint: %v", service.Hint, tt.wantHint)
+			}
+		})
+	}
+}
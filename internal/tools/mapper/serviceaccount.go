@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceAccountListContent represents ServiceAccount-specific fields for list display
+type ServiceAccountListContent struct {
+	Name              string   `json:"name"`
+	Namespace         string   `json:"namespace,omitempty"`
+	Secrets           int      `json:"secrets"`
+	ImagePullSecrets  []string `json:"imagePullSecrets,omitempty"`
+	AutomountDisabled bool     `json:"automountDisabled,omitempty"`
+	Age               string   `json:"age,omitempty"`
+}
+
+func init() {
+	// Register ServiceAccount mapper
+	Register(
+		schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ServiceAccount"},
+		mapServiceAccountResource,
+	)
+}
+
+func mapServiceAccountResource(item unstructured.Unstructured) any {
+	serviceAccount := ServiceAccountListContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+
+	if created := item.GetCreationTimestamp(); !created.IsZero() {
+		serviceAccount.Age = formatDuration(time.Since(created.Time))
+	}
+
+	if secrets, found, _ := unstructured.NestedSlice(item.Object, "secrets"); found {
+		serviceAccount.Secrets = len(secrets)
+	}
+
+	// Extract image pull secret names
+	if pullSecrets, found, _ := unstructured.NestedSlice(item.Object, "imagePullSecrets"); found {
+		for _, s := range pullSecrets {
+			if secretMap, ok := s.(map[string]any); ok {
+				if name, found, _ := unstructured.NestedString(secretMap, "name"); found {
+					serviceAccount.ImagePullSecrets = append(serviceAccount.ImagePullSecrets, name)
+				}
+			}
+		}
+	}
+
+	// Token automounting defaults to enabled; only an explicit false disables it
+	if automount, found, _ := unstructured.NestedBool(item.Object, "automountServiceAccountToken"); found && !automount {
+		serviceAccount.AutomountDisabled = true
+	}
+
+	return serviceAccount
+}
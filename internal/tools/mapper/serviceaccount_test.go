@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapServiceAccountResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{
+			"name":              "builder",
+			"namespace":         "ci",
+			"creationTimestamp": time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+		},
+		"secrets":                      []any{map[string]any{"name": "builder-token"}},
+		"imagePullSecrets":             []any{map[string]any{"name": "registry"}},
+		"automountServiceAccountToken": false,
+	}}
+
+	got := mapServiceAccountResource(item).(ServiceAccountListContent)
+	if got.Secrets != 1 || len(got.ImagePullSecrets) != 1 || got.ImagePullSecrets[0] != "registry" || !got.AutomountDisabled {
+		t.Errorf("unexpected content: %+v", got)
+	}
+	if got.Age == "" {
+		t.Error("expected an age")
+	}
+
+	// Objects without a creation timestamp have no age rather than one measured from year 1
+	withoutTimestamp := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "builder"},
+	}}
+	if got := mapServiceAccountResource(withoutTimestamp).(ServiceAccountListContent); got.Age != "" {
+		t.Errorf("expected empty age, got %q", got.Age)
+	}
+}
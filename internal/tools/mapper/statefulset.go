@@ -9,10 +9,16 @@ import (
 
 // StatefulSetListContent represents StatefulSet-specific fields for list display
 type StatefulSetListContent struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace,omitempty"`
-	Ready     string `json:"ready,omitempty"`
-	Age       string `json:"age,omitempty"`
+	Name            string              `json:"name"`
+	Namespace       string              `json:"namespace,omitempty"`
+	Ready           string              `json:"ready,omitempty"`
+	Current         int64               `json:"current,omitempty"`
+	Updated         int64               `json:"updated,omitempty"`
+	CurrentRevision string              `json:"currentRevision,omitempty"`
+	UpdateRevision  string              `json:"updateRevision,omitempty"`
+	RolloutComplete bool                `json:"rolloutComplete"`
+	Age             string              `json:"age,omitempty"`
+	Template        *PodTemplateSummary `json:"template,omitempty"`
 }
 
 func init() {
@@ -38,6 +44,28 @@ func mapStatefulSetResource(item unstructured.Unstructured) any {
 		}
 	}
 
+	if current, found, _ := unstructured.NestedInt64(item.Object, "status", "currentReplicas"); found {
+		statefulSet.Current = current
+	}
+
+	if updated, found, _ := unstructured.NestedInt64(item.Object, "status", "updatedReplicas"); found {
+		statefulSet.Updated = updated
+	}
+
+	// A revision mismatch means a rollout is still in progress
+	if currentRevision, found, _ := unstructured.NestedString(item.Object, "status", "currentRevision"); found {
+		statefulSet.CurrentRevision = currentRevision
+	}
+
+	if updateRevision, found, _ := unstructured.NestedString(item.Object, "status", "updateRevision"); found {
+		statefulSet.UpdateRevision = updateRevision
+	}
+
+	statefulSet.RolloutComplete = statefulSet.UpdateRevision == "" || statefulSet.CurrentRevision == statefulSet.UpdateRevision
+
+	// Summarize the pod template (containers, images and aggregate resources)
+	statefulSet.Template = summarizePodTemplate(item, "spec", "template", "spec")
+
 	// TODO: Calculate age from creation timestamp
 
 	return statefulSet
@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VerticalPodAutoscalerListContent represents VerticalPodAutoscaler-specific fields for list display
+type VerticalPodAutoscalerListContent struct {
+	Name            string                       `json:"name"`
+	Namespace       string                       `json:"namespace,omitempty"`
+	Target          string                       `json:"target,omitempty"`     // e.g. "Deployment/web"
+	UpdateMode      string                       `json:"updateMode,omitempty"` // Off, Initial, Recreate, Auto
+	Recommendations []VPAContainerRecommendation `json:"recommendations,omitempty"`
+}
+
+// VPAContainerRecommendation represents the recommended requests for a single container
+type VPAContainerRecommendation struct {
+	Container        string `json:"container"`
+	TargetCPU        string `json:"targetCPU,omitempty"`
+	TargetMemory     string `json:"targetMemory,omitempty"`
+	LowerBoundCPU    string `json:"lowerBoundCPU,omitempty"`
+	LowerBoundMemory string `json:"lowerBoundMemory,omitempty"`
+	UpperBoundCPU    string `json:"upperBoundCPU,omitempty"`
+	UpperBoundMemory string `json:"upperBoundMemory,omitempty"`
+}
+
+func init() {
+	// Register VerticalPodAutoscaler mapper
+	Register(
+		schema.GroupVersionKind{Group: "autoscaling.k8s.io", Version: "v1", Kind: "VerticalPodAutoscaler"},
+		mapVerticalPodAutoscalerResource,
+	)
+}
+
+func mapVerticalPodAutoscalerResource(item unstructured.Unstructured) any {
+	vpa := VerticalPodAutoscalerListContent{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+
+	// Extract target reference
+	if kind, found, _ := unstructured.NestedString(item.Object, "spec", "targetRef", "kind"); found {
+		name, _, _ := unstructured.NestedString(item.Object, "spec", "targetRef", "name")
+		vpa.Target = fmt.Sprintf("%s/%s", kind, name)
+	}
+
+	// Update mode defaults to Auto when unset
+	vpa.UpdateMode = "Auto"
+	if updateMode, found, _ := unstructured.NestedString(item.Object, "spec", "updatePolicy", "updateMode"); found && updateMode != "" {
+		vpa.UpdateMode = updateMode
+	}
+
+	// Extract per-container recommendations
+	if recommendations, found, _ := unstructured.NestedSlice(item.Object, "status", "recommendation", "containerRecommendations"); found {
+		for _, r := range recommendations {
+			recMap, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+			rec := VPAContainerRecommendation{}
+			rec.Container, _, _ = unstructured.NestedString(recMap, "containerName")
+			rec.TargetCPU, _, _ = unstructured.NestedString(recMap, "target", "cpu")
+			rec.TargetMemory, _, _ = unstructured.NestedString(recMap, "target", "memory")
+			rec.LowerBoundCPU, _, _ = unstructured.NestedString(recMap, "lowerBound", "cpu")
+			rec.LowerBoundMemory, _, _ = unstructured.NestedString(recMap, "lowerBound", "memory")
+			rec.UpperBoundCPU, _, _ = unstructured.NestedString(recMap, "upperBound", "cpu")
+			rec.UpperBoundMemory, _, _ = unstructured.NestedString(recMap, "upperBound", "memory")
+			vpa.Recommendations = append(vpa.Recommendations, rec)
+		}
+	}
+
+	return vpa
+}
@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapVerticalPodAutoscalerResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "web", "namespace": "shop"},
+		"spec": map[string]any{
+			"targetRef":    map[string]any{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"},
+			"updatePolicy": map[string]any{"updateMode": "Off"},
+		},
+		"status": map[string]any{
+			"recommendation": map[string]any{
+				"containerRecommendations": []any{
+					map[string]any{
+						"containerName": "app",
+						"target":        map[string]any{"cpu": "250m", "memory": "512Mi"},
+						"lowerBound":    map[string]any{"cpu": "100m", "memory": "256Mi"},
+						"upperBound":    map[string]any{"cpu": "1", "memory": "1Gi"},
+					},
+					map[string]any{
+						"containerName": "proxy",
+						"target":        map[string]any{"cpu": "25m", "memory": "64Mi"},
+					},
+				},
+			},
+		},
+	}}
+
+	got := mapVerticalPodAutoscalerResource(item).(VerticalPodAutoscalerListContent)
+	want := VerticalPodAutoscalerListContent{
+		Name:       "web",
+		Namespace:  "shop",
+		Target:     "Deployment/web",
+		UpdateMode: "Off",
+		Recommendations: []VPAContainerRecommendation{
+			{
+				Container: "app", TargetCPU: "250m", TargetMemory: "512Mi",
+				LowerBoundCPU: "100m", LowerBoundMemory: "256Mi", UpperBoundCPU: "1", UpperBoundMemory: "1Gi",
+			},
+			{Container: "proxy", TargetCPU: "25m", TargetMemory: "64Mi"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// Without an update policy or recommendations yet, the mode defaults to Auto
+	pending := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "api", "namespace": "shop"},
+		"spec":     map[string]any{"targetRef": map[string]any{"kind": "StatefulSet", "name": "api"}},
+	}}
+	got = mapVerticalPodAutoscalerResource(pending).(VerticalPodAutoscalerListContent)
+	if got.Target != "StatefulSet/api" || got.UpdateMode != "Auto" || got.Recommendations != nil {
+		t.Errorf("unexpected content: %+v", got)
+	}
+}
@@ -0,0 +1,131 @@
+package mapper
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WebhookConfigurationListContent represents Mutating/ValidatingWebhookConfiguration-specific fields for list display
+type WebhookConfigurationListContent struct {
+	Name     string           `json:"name"`
+	Webhooks []WebhookContent `json:"webhooks,omitempty"`
+}
+
+// WebhookContent summarizes a single admission webhook
+type WebhookContent struct {
+	Name              string   `json:"name"`
+	Service           string   `json:"service,omitempty"` // namespace/name:port or URL
+	FailurePolicy     string   `json:"failurePolicy,omitempty"`
+	SideEffects       string   `json:"sideEffects,omitempty"`
+	TimeoutSeconds    int64    `json:"timeoutSeconds,omitempty"`
+	Rules             []string `json:"rules,omitempty"` // operations on group/version/resources
+	NamespaceSelector bool     `json:"namespaceSelector,omitempty"`
+	ObjectSelector    bool     `json:"objectSelector,omitempty"`
+}
+
+func init() {
+	// Register admission webhook configuration mappers
+	Register(
+		schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+		mapWebhookConfigurationResource,
+	)
+	Register(
+		schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"},
+		mapWebhookConfigurationResource,
+	)
+}
+
+func mapWebhookConfigurationResource(item unstructured.Unstructured) any {
+	config := WebhookConfigurationListContent{
+		Name: item.GetName(),
+		// Webhook configurations are cluster-scoped
+	}
+
+	webhooks, found, _ := unstructured.NestedSlice(item.Object, "webhooks")
+	if !found {
+		return config
+	}
+
+	for _, w := range webhooks {
+		webhookMap, ok := w.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		webhook := WebhookContent{}
+		if name, found, _ := unstructured.NestedString(webhookMap, "name"); found {
+			webhook.Name = name
+		}
+
+		// Extract the webhook target
+		if service, found, _ := unstructured.NestedMap(webhookMap, "clientConfig", "service"); found {
+			namespace, _, _ := unstructured.NestedString(service, "namespace")
+			name, _, _ := unstructured.NestedString(service, "name")
+			webhook.Service = namespace + "/" + name
+			if port, found, _ := unstructured.NestedInt64(service, "port"); found {
+				webhook.Service += fmt.Sprintf(":%d", port)
+			}
+		} else if url, found, _ := unstructured.NestedString(webhookMap, "clientConfig", "url"); found {
+			webhook.Service = url
+		}
+
+		if failurePolicy, found, _ := unstructured.NestedString(webhookMap, "failurePolicy"); found {
+			webhook.FailurePolicy = failurePolicy
+		}
+
+		if sideEffects, found, _ := unstructured.NestedString(webhookMap, "sideEffects"); found {
+			webhook.SideEffects = sideEffects
+		}
+
+		if timeoutSeconds, found, _ := unstructured.NestedInt64(webhookMap, "timeoutSeconds"); found {
+			webhook.TimeoutSeconds = timeoutSeconds
+		}
+
+		// Summarize intercepted operations and resources
+		if rules, found, _ := unstructured.NestedSlice(webhookMap, "rules"); found {
+			for _, r := range rules {
+				if ruleMap, ok := r.(map[string]any); ok {
+					webhook.Rules = append(webhook.Rules, formatWebhookRule(ruleMap))
+				}
+			}
+		}
+
+		// Selectors narrow which objects are intercepted
+		if selector, found, _ := unstructured.NestedMap(webhookMap, "namespaceSelector"); found && len(selector) > 0 {
+			webhook.NamespaceSelector = true
+		}
+
+		if selector, found, _ := unstructured.NestedMap(webhookMap, "objectSelector"); found && len(selector) > 0 {
+			webhook.ObjectSelector = true
+		}
+
+		config.Webhooks = append(config.Webhooks, webhook)
+	}
+
+	return config
+}
+
+// formatWebhookRule renders a rule like "CREATE,UPDATE apps/v1 deployments,replicasets"
+func formatWebhookRule(rule map[string]any) string {
+	operations, _, _ := unstructured.NestedStringSlice(rule, "operations")
+	apiGroups, _, _ := unstructured.NestedStringSlice(rule, "apiGroups")
+	apiVersions, _, _ := unstructured.NestedStringSlice(rule, "apiVersions")
+	resources, _, _ := unstructured.NestedStringSlice(rule, "resources")
+
+	// Show the core group as "core" for readability
+	for i, group := range apiGroups {
+		if group == "" {
+			apiGroups[i] = "core"
+		}
+	}
+
+	return fmt.Sprintf("%s %s/%s %s",
+		strings.Join(operations, ","),
+		strings.Join(apiGroups, ","),
+		strings.Join(apiVersions, ","),
+		strings.Join(resources, ","),
+	)
+}
@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapWebhookConfigurationResource(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "policy-webhooks"},
+		"webhooks": []any{
+			map[string]any{
+				"name": "validate.policy.example.com",
+				"clientConfig": map[string]any{
+					"service": map[string]any{"namespace": "policy", "name": "webhook", "port": int64(8443)},
+				},
+				"failurePolicy":  "Fail",
+				"sideEffects":    "None",
+				"timeoutSeconds": int64(5),
+				"rules": []any{
+					map[string]any{
+						"operations":  []any{"CREATE", "UPDATE"},
+						"apiGroups":   []any{"", "apps"},
+						"apiVersions": []any{"v1"},
+						"resources":   []any{"pods", "deployments"},
+					},
+				},
+				"namespaceSelector": map[string]any{"matchLabels": map[string]any{"policy": "enforced"}},
+				"objectSelector":    map[string]any{},
+			},
+			map[string]any{
+				"name":         "external.example.com",
+				"clientConfig": map[string]any{"url": "https://hooks.example.com/validate"},
+			},
+		},
+	}}
+
+	got := mapWebhookConfigurationResource(item).(WebhookConfigurationListContent)
+	want := WebhookConfigurationListContent{
+		Name: "policy-webhooks",
+		Webhooks: []WebhookContent{
+			{
+				Name:              "validate.policy.example.com",
+				Service:           "policy/webhook:8443",
+				FailurePolicy:     "Fail",
+				SideEffects:       "None",
+				TimeoutSeconds:    5,
+				Rules:             []string{"CREATE,UPDATE core,apps/v1 pods,deployments"},
+				NamespaceSelector: true,
+			},
+			{Name: "external.example.com", Service: "https://hooks.example.com/validate"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// Configurations without webhooks map to just the name
+	empty := unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{"name": "empty"}}}
+	if got := mapWebhookConfigurationResource(empty).(WebhookConfigurationListContent); got.Name != "empty" || got.Webhooks != nil {
+		t.Errorf("unexpected content: %+v", got)
+	}
+}
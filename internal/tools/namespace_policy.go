@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// allowedNamespaces and deniedNamespaces restrict which namespaces tools may access. When either
+// is set, requests spanning all namespaces are rejected since they would expose other namespaces.
+var (
+	allowedNamespaces = map[string]bool{}
+	deniedNamespaces  = map[string]bool{}
+)
+
+// SetNamespacePolicy restricts tools to the allowed namespaces (all if empty) minus the denied
+// ones, e.g. to safely expose the server to users who should only see their own namespaces.
+// Cluster-scoped resources are not affected.
+func SetNamespacePolicy(allowed, denied []string) {
+	allowedNamespaces = make(map[string]bool, len(allowed))
+	for _, namespace := range allowed {
+		allowedNamespaces[namespace] = true
+	}
+	deniedNamespaces = make(map[string]bool, len(denied))
+	for _, namespace := range denied {
+		deniedNamespaces[namespace] = true
+	}
+}
+
+// CheckNamespaceAccess returns an error if the namespace policy forbids access to namespace.
+// An empty namespace means all namespaces, which is forbidden whenever a policy is configured.
+// Exported so the k8s:// resource template enforces the same policy as tools.
+func CheckNamespaceAccess(namespace string) error {
+	if len(allowedNamespaces) == 0 && len(deniedNamespaces) == 0 {
+		return nil
+	}
+
+	if namespace == metav1.NamespaceAll {
+		if len(allowedNamespaces) > 0 {
+			return fmt.Errorf("access across all namespaces is not permitted by this server's namespace policy; specify one of the allowed namespaces: %s", strings.Join(sortedKeys(allowedNamespaces), ", "))
+		}
+		return fmt.Errorf("access across all namespaces is not permitted by this server's namespace policy; specify a namespace")
+	}
+	if deniedNamespaces[namespace] {
+		return fmt.Errorf("access to namespace '%s' is denied by this server's namespace policy", namespace)
+	}
+	if len(allowedNamespaces) > 0 && !allowedNamespaces[namespace] {
+		return fmt.Errorf("namespace '%s' is not permitted by this server's namespace policy; allowed namespaces: %s", namespace, strings.Join(sortedKeys(allowedNamespaces), ", "))
+	}
+	return nil
+}
+
+// enforceNamespacePolicy wraps a handler so explicit namespace and allNamespaces arguments are
+// checked against the namespace policy before the tool runs. Tools that fall back to a default
+// namespace check the resolved namespace themselves (see resolveNamespace).
+func enforceNamespacePolicy(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if namespace := request.GetString(namespaceProperty, ""); namespace != "" {
+			if err := CheckNamespaceAccess(namespace); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		if request.GetBool(allNamespacesProperty, false) {
+			if err := CheckNamespaceAccess(metav1.NamespaceAll); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		return handler(ctx, request)
+	}
+}
@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestCheckNamespaceAccess(t *testing.T) {
+	t.Cleanup(func() { SetNamespacePolicy(nil, nil) })
+
+	tests := []struct {
+		name      string
+		allowed   []string
+		denied    []string
+		namespace string
+		wantErr   bool
+	}{
+		{name: "no policy allows everything", namespace: "kube-system"},
+		{name: "no policy allows all namespaces", namespace: ""},
+		{name: "allowlisted namespace", allowed: []string{"team-a"}, namespace: "team-a"},
+		{name: "namespace outside allowlist", allowed: []string{"team-a"}, namespace: "team-b", wantErr: true},
+		{name: "denylisted namespace", denied: []string{"kube-system"}, namespace: "kube-system", wantErr: true},
+		{name: "namespace not denylisted", denied: []string{"kube-system"}, namespace: "team-a"},
+		{name: "deny overrides allow", allowed: []string{"team-a"}, denied: []string{"team-a"}, namespace: "team-a", wantErr: true},
+		{name: "all namespaces with allowlist", allowed: []string{"team-a"}, namespace: "", wantErr: true},
+		{name: "all namespaces with denylist", denied: []string{"kube-system"}, namespace: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetNamespacePolicy(tt.allowed, tt.denied)
+			err := CheckNamespaceAccess(tt.namespace)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckNamespaceAccess(%q) error = %v, wantErr %t", tt.namespace, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnforceNamespacePolicy(t *testing.T) {
+	SetNamespacePolicy([]string{"team-a"}, nil)
+	t.Cleanup(func() { SetNamespacePolicy(nil, nil) })
+
+	handler := enforceNamespacePolicy(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	tests := []struct {
+		name      string
+		args      map[string]any
+		wantError bool
+	}{
+		{name: "allowed namespace", args: map[string]any{"namespace": "team-a"}},
+		{name: "no namespace argument", args: map[string]any{}},
+		{name: "denied namespace", args: map[string]any{"namespace": "team-b"}, wantError: true},
+		{name: "all namespaces", args: map[string]any{"allNamespaces": true}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+
+			result, err := handler(context.Background(), request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.IsError != tt.wantError {
+				t.Errorf("IsError = %t, want %t: %+v", result.IsError, tt.wantError, result.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// DefaultRedactPattern matches keys that commonly hold credentials, e.g. DB_PASSWORD,
+// client_secret, GITHUB_TOKEN, or apiKey, without matching references such as secretName
+const DefaultRedactPattern = `(password|passwd|secret|token|api[-_.]?key|access[-_.]?key|private[-_.]?key|credentials?)$`
+
+// redactedValue replaces string values under matching keys
+const redactedValue = "REDACTED"
+
+// redactPattern matches keys whose values are masked in tool output; nil disables redaction
+var redactPattern *regexp.Regexp
+
+// SetRedactPattern masks string values of keys matching pattern (case-insensitively) in tool
+// output, e.g. ConfigMap data or container env values. An empty pattern disables redaction.
+func SetRedactPattern(pattern string) error {
+	if pattern == "" {
+		redactPattern = nil
+		return nil
+	}
+
+	compiled, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return fmt.Errorf("invalid redact pattern %q: %w", pattern, err)
+	}
+	redactPattern = compiled
+	return nil
+}
+
+// MarshalRedactedJSON encodes content as JSON with sensitive values masked when redaction is enabled.
+// Exported so the k8s:// resource template redacts the same way as tools.
+func MarshalRedactedJSON(content any) ([]byte, error) {
+	encoded, err := json.Marshal(content)
+	if err != nil || redactPattern == nil {
+		return encoded, err
+	}
+	return redactJSON(encoded)
+}
+
+// redactObject returns a copy of an unstructured object with sensitive values masked, or the
+// object itself when redaction is disabled. Numbers are kept as json.Number so Go templates
+// print them as written.
+func redactObject(object map[string]any) (map[string]any, error) {
+	if redactPattern == nil {
+		return object, nil
+	}
+
+	encoded, err := MarshalRedactedJSON(object)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var redacted map[string]any
+	if err := decoder.Decode(&redacted); err != nil {
+		return nil, err
+	}
+	return redacted, nil
+}
+
+// redactJSON masks string values under keys matching redactPattern, and the value of any
+// {"name": ..., "value": ...} pair whose name matches, as in container env vars. Everything under
+// a matching key is masked, as are all Secret data and stringData values. The last-applied
+// configuration annotation is redacted as the object it encodes. Key order is preserved so mapper
+// output reads the same.
+func redactJSON(encoded []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	value, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactOrderedJSON(value, false))
+}
+
+// orderedJSONObject is a decoded JSON object that keeps its keys in their original order
+type orderedJSONObject []orderedJSONField
+
+type orderedJSONField struct {
+	Key   string
+	Value any
+}
+
+func (o orderedJSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeOrderedJSON decodes the next JSON value, representing objects as orderedJSONObject
+func decodeOrderedJSON(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		object := orderedJSONObject{}
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyToken)
+			}
+			value, err := decodeOrderedJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, orderedJSONField{Key: key, Value: value})
+		}
+		_, err := decoder.Token() // Closing brace
+		return object, err
+	case json.Delim('['):
+		array := []any{}
+		for decoder.More() {
+			value, err := decodeOrderedJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err := decoder.Token() // Closing bracket
+		return array, err
+	case nil:
+		return nil, nil
+	}
+
+	if delim, ok := token.(json.Delim); ok {
+		return nil, fmt.Errorf("unexpected delimiter %v", delim)
+	}
+	return token, nil
+}
+
+// redactEmbeddedJSON redacts a JSON document stored as a string, such as the whole object kept in
+// the last-applied-configuration annotation. Strings that don't parse are masked entirely.
+func redactEmbeddedJSON(encoded string) string {
+	if encoded == "" {
+		return encoded
+	}
+	redacted, err := redactJSON([]byte(encoded))
+	if err != nil {
+		return redactedValue
+	}
+	return string(redacted)
+}
+
+// redactOrderedJSON masks string values in a decoded JSON value, masking all strings when redactAll
+func redactOrderedJSON(value any, redactAll bool) any {
+	switch v := value.(type) {
+	case string:
+		if redactAll && v != "" {
+			return redactedValue
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = redactOrderedJSON(v[i], redactAll)
+		}
+		return v
+	case orderedJSONObject:
+		// Name/value pairs such as env vars carry the sensitive key in their name, while Secret
+		// values are credentials whatever their keys are named (e.g. tls.key or .dockerconfigjson)
+		redactValueField, isSecret := false, false
+		for _, field := range v {
+			value, ok := field.Value.(string)
+			if !ok {
+				continue
+			}
+			if field.Key == "name" && redactPattern.MatchString(value) {
+				redactValueField = true
+			}
+			if field.Key == "kind" && value == "Secret" {
+				isSecret = true
+			}
+		}
+		for i, field := range v {
+			if annotation, ok := field.Value.(string); ok && !redactAll && field.Key == lastAppliedConfigAnnotation {
+				v[i].Value = redactEmbeddedJSON(annotation)
+				continue
+			}
+			redactField := redactAll || redactPattern.MatchString(field.Key) ||
+				(redactValueField && field.Key == "value") ||
+				(isSecret && (field.Key == "data" || field.Key == "stringData"))
+			v[i].Value = redactOrderedJSON(field.Value, redactField)
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRedactJSON(t *testing.T) {
+	if err := SetRedactPattern(DefaultRedactPattern); err != nil {
+		t.Fatalf("SetRedactPattern() error = %v", err)
+	}
+	defer func() { _ = SetRedactPattern("") }()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "configmap data keeps key order",
+			in:   `{"name":"app","data":{"LOG_LEVEL":"debug","DB_PASSWORD":"hunter2","github_token":"ghp_x"}}`,
+			want: `{"name":"app","data":{"LOG_LEVEL":"debug","DB_PASSWORD":"REDACTED","github_token":"REDACTED"}}`,
+		},
+		{
+			name: "env var name/value pairs",
+			in:   `{"env":[{"name":"API_KEY","value":"abc"},{"name":"PORT","value":"8080"}]}`,
+			want: `{"env":[{"name":"API_KEY","value":"REDACTED"},{"name":"PORT","value":"8080"}]}`,
+		},
+		{
+			name: "everything under a matching key",
+			in:   `{"credentials":{"user":"admin","pass":["a","b"]},"replicas":3}`,
+			want: `{"credentials":{"user":"REDACTED","pass":["REDACTED","REDACTED"]},"replicas":3}`,
+		},
+		{
+			name: "secret data masked regardless of key",
+			in:   `{"kind":"Secret","type":"kubernetes.io/tls","data":{"tls.crt":"Y2VydA==","tls.key":"a2V5"},"stringData":{".dockerconfigjson":"{}"}}`,
+			want: `{"kind":"Secret","type":"kubernetes.io/tls","data":{"tls.crt":"REDACTED","tls.key":"REDACTED"},"stringData":{".dockerconfigjson":"REDACTED"}}`,
+		},
+		{
+			name: "data of other kinds masked by key only",
+			in:   `{"kind":"ConfigMap","data":{"tls.key":"plain","DB_PASSWORD":"hunter2"}}`,
+			want: `{"kind":"ConfigMap","data":{"tls.key":"plain","DB_PASSWORD":"REDACTED"}}`,
+		},
+		{
+			name: "last-applied annotation redacted as an object",
+			in:   `{"metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"kind\":\"ConfigMap\",\"data\":{\"DB_PASSWORD\":\"hunter2\",\"LOG_LEVEL\":\"debug\"}}\n"}}}`,
+			want: `{"metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"kind\":\"ConfigMap\",\"data\":{\"DB_PASSWORD\":\"REDACTED\",\"LOG_LEVEL\":\"debug\"}}"}}}`,
+		},
+		{
+			name: "unparseable last-applied annotation masked",
+			in:   `{"metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"not json"}}}`,
+			want: `{"metadata":{"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"REDACTED"}}}`,
+		},
+		{
+			name: "references and non-strings kept",
+			in:   `{"secretName":"tls-cert","automountServiceAccountToken":false,"token":"","size":1.5e3}`,
+			want: `{"secretName":"tls-cert","automountServiceAccountToken":false,"token":"","size":1.5e3}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := redactJSON([]byte(tt.in))
+			if err != nil {
+				t.Fatalf("redactJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("redactJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactionInToolResults(t *testing.T) {
+	configMap := map[string]any{"data": map[string]any{"password": "hunter2"}}
+
+	result, _ := toJSONToolResult(configMap)
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "hunter2") {
+		t.Fatalf("expected unredacted output with redaction disabled, got %s", text)
+	}
+
+	if err := SetRedactPattern("password$"); err != nil {
+		t.Fatalf("SetRedactPattern() error = %v", err)
+	}
+	defer func() { _ = SetRedactPattern("") }()
+
+	jsonResult, _ := toJSONToolResult(configMap)
+	yamlResult, _ := toYAMLToolResult(configMap)
+	templateOutput, err := executeGoTemplate(&unstructured.Unstructured{Object: configMap}, "{{.data.password}}")
+	if err != nil {
+		t.Fatalf("executeGoTemplate() error = %v", err)
+	}
+
+	outputs := map[string]string{
+		"json":        jsonResult.Content[0].(mcp.TextContent).Text,
+		"yaml":        yamlResult.Content[0].(mcp.TextContent).Text,
+		"go_template": templateOutput,
+	}
+	for name, output := range outputs {
+		if strings.Contains(output, "hunter2") || !strings.Contains(output, redactedValue) {
+			t.Errorf("%s output not redacted: %s", name, output)
+		}
+	}
+}
+
+func TestSetRedactPatternRejectsInvalidRegex(t *testing.T) {
+	if err := SetRedactPattern("(unclosed"); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
+
+func TestMarshalRedactedJSON(t *testing.T) {
+	configMap := map[string]any{"data": map[string]any{"DB_PASSWORD": "hunter2", "DB_HOST": "db"}}
+
+	if err := SetRedactPattern(DefaultRedactPattern); err != nil {
+		t.Fatalf("SetRedactPattern() error = %v", err)
+	}
+	defer func() { _ = SetRedactPattern("") }()
+
+	encoded, err := MarshalRedactedJSON(configMap)
+	if err != nil {
+		t.Fatalf("MarshalRedactedJSON() error = %v", err)
+	}
+	if want := `{"data":{"DB_HOST":"db","DB_PASSWORD":"REDACTED"}}`; string(encoded) != want {
+		t.Errorf("expected %s, got %s", want, encoded)
+	}
+}
@@ -4,19 +4,86 @@
 package tools
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/krmcbride/mcp-k8s/internal/tools/mapper"
 )
 
-func RegisterMCPTools(s *server.MCPServer) {
+// disabledTools holds tool names operators asked not to register, mapped to whether a tool
+// with that name was seen during registration
+var disabledTools = map[string]bool{}
+
+// SetDisabledTools sets the tools to skip during registration, e.g. to hide pod logs for compliance
+func SetDisabledTools(names []string) {
+	disabledTools = make(map[string]bool, len(names))
+	for _, name := range names {
+		disabledTools[name] = false
+	}
+}
+
+// IsToolDisabled reports whether the operator disabled the named tool, so resources that expose
+// the same data, like the k8s:// template for get_k8s_resource, can be hidden with it
+func IsToolDisabled(name string) bool {
+	_, disabled := disabledTools[name]
+	return disabled
+}
+
+func RegisterMCPTools(s *server.MCPServer) error {
 	// Initialize resource mappers
 	mapper.Init()
 
 	// Register tools
 	RegisterListK8sResourcesMCPTool(s)
+	RegisterListK8sResourcesMultiMCPTool(s)
+	RegisterCountK8sResourcesMCPTool(s)
+	RegisterListK8sNamespaceInventoryMCPTool(s)
+	RegisterGetK8sNamespaceGraphMCPTool(s)
+	RegisterListK8sContextsMCPTool(s)
 	RegisterListK8sAPIResourcesMCPTool(s)
+	RegisterResolveK8sKindMCPTool(s)
 	RegisterGetK8sResourceMCPTool(s)
+	RegisterK8sResourceExistsMCPTool(s)
+	RegisterK8sCanIMCPTool(s)
 	RegisterGetK8sMetricsMCPTool(s)
+	RegisterCompareK8sPodMetricsMCPTool(s)
+	RegisterListK8sPodsOnNodeMCPTool(s)
+	RegisterListK8sWorkloadPodsMCPTool(s)
+	RegisterListK8sWarningsMCPTool(s)
+	RegisterListK8sRestartingPodsMCPTool(s)
 	RegisterGetK8sPodLogsMCPTool(s)
+	RegisterGetK8sPodLogsBySelectorMCPTool(s)
+	RegisterWaitK8sResourceMCPTool(s)
+	RegisterWatchK8sResourcesMCPTool(s)
+	RegisterExplainK8sResourceMCPTool(s)
+	RegisterGetK8sResourceSchemaMCPTool(s)
+	RegisterCheckK8sServiceEndpointsMCPTool(s)
+	RegisterGetK8sRolloutStatusMCPTool(s)
+	RegisterGetK8sHPAStatusMCPTool(s)
+
+	// Catch typos so a tool an operator meant to disable isn't silently exposed
+	var unknown []string
+	for name, seen := range disabledTools {
+		if !seen {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("cannot disable unknown tools: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// addTool registers a tool unless it has been disabled, warning about unknown parameters in its results
+func addTool(s *server.MCPServer, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	if _, disabled := disabledTools[tool.Name]; disabled {
+		disabledTools[tool.Name] = true
+		return
+	}
+	s.AddTool(tool, warnUnknownParameters(tool, enforceNamespacePolicy(handler)))
 }
@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestRegisterMCPToolsDisabled(t *testing.T) {
+	t.Cleanup(func() { SetDisabledTools(nil) })
+
+	t.Run("skips disabled tools", func(t *testing.T) {
+		SetDisabledTools([]string{"get_k8s_pod_logs"})
+		s := server.NewMCPServer("test", "test", server.WithToolCapabilities(false))
+		if err := RegisterMCPTools(s); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		message := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+		response, ok := message.(mcp.JSONRPCResponse)
+		if !ok {
+			t.Fatalf("unexpected response type %T: %+v", message, message)
+		}
+		result, ok := response.Result.(mcp.ListToolsResult)
+		if !ok {
+			t.Fatalf("unexpected result type %T", response.Result)
+		}
+
+		registered := make(map[string]bool, len(result.Tools))
+		for _, tool := range result.Tools {
+			registered[tool.Name] = true
+		}
+		if registered["get_k8s_pod_logs"] {
+			t.Error("expected get_k8s_pod_logs not to be registered")
+		}
+		if !registered["get_k8s_pod_logs_by_selector"] {
+			t.Error("expected get_k8s_pod_logs_by_selector to be registered")
+		}
+	})
+
+	t.Run("rejects unknown tools", func(t *testing.T) {
+		SetDisabledTools([]string{"get_k8s_pod_log"})
+		s := server.NewMCPServer("test", "test", server.WithToolCapabilities(false))
+		if err := RegisterMCPTools(s); err == nil {
+			t.Fatal("expected error for unknown tool, got nil")
+		}
+	})
+}
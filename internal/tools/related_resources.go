@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// Well-known resource types used when following drill-down chains
+var (
+	podGVR           = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	replicaSetGVR    = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+	jobGVR           = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+	endpointSliceGVR = schema.GroupVersionResource{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"}
+
+	podGVK           = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	replicaSetGVK    = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}
+	jobGVK           = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	endpointSliceGVK = schema.GroupVersionKind{Group: "discovery.k8s.io", Version: "v1", Kind: "EndpointSlice"}
+)
+
+// getRelatedResources follows selectors and ownerReferences for well-known kinds, returning
+// mapped child resources keyed by Kind:
+//   - Deployment -> ReplicaSets -> Pods
+//   - StatefulSet, DaemonSet, Job -> Pods
+//   - CronJob -> Jobs -> Pods
+//   - Service -> EndpointSlices and selected Pods
+//
+// Each list is bounded by maxListLimit like list_k8s_resources; the kinds whose list hit the
+// bound are returned as truncated. Returns nil if the kind has no known related resources.
+func getRelatedResources(ctx context.Context, dynamicClient dynamic.Interface, resource *unstructured.Unstructured) (map[string][]any, []string, error) {
+	namespace := resource.GetNamespace()
+	related := map[string][]any{}
+	var truncated []string
+	markTruncated := func(kind string, isTruncated bool) {
+		if isTruncated {
+			truncated = append(truncated, kind)
+		}
+	}
+
+	switch strings.ToLower(resource.GetKind()) {
+	case "deployment":
+		replicaSets, replicaSetsTruncated, err := listOwnedResources(ctx, dynamicClient, replicaSetGVR, namespace, resource, []types.UID{resource.GetUID()})
+		if err != nil {
+			return nil, nil, err
+		}
+		related["ReplicaSet"] = mapRelated(replicaSets, replicaSetGVK)
+		markTruncated("ReplicaSet", replicaSetsTruncated)
+
+		owners := make([]types.UID, 0, len(replicaSets))
+		for _, rs := range replicaSets {
+			owners = append(owners, rs.GetUID())
+		}
+		pods, podsTruncated, err := listOwnedResources(ctx, dynamicClient, podGVR, namespace, resource, owners)
+		if err != nil {
+			return nil, nil, err
+		}
+		related["Pod"] = mapRelated(pods, podGVK)
+		markTruncated("Pod", podsTruncated)
+
+	case "statefulset", "daemonset", "job":
+		pods, podsTruncated, err := listOwnedResources(ctx, dynamicClient, podGVR, namespace, resource, []types.UID{resource.GetUID()})
+		if err != nil {
+			return nil, nil, err
+		}
+		related["Pod"] = mapRelated(pods, podGVK)
+		markTruncated("Pod", podsTruncated)
+
+	case "cronjob":
+		// Jobs created by a CronJob have no selector on the CronJob, so match on ownerReferences only
+		jobs, jobsTruncated, err := listOwnedResources(ctx, dynamicClient, jobGVR, namespace, nil, []types.UID{resource.GetUID()})
+		if err != nil {
+			return nil, nil, err
+		}
+		related["Job"] = mapRelated(jobs, jobGVK)
+		markTruncated("Job", jobsTruncated)
+
+		owners := make([]types.UID, 0, len(jobs))
+		for _, job := range jobs {
+			owners = append(owners, job.GetUID())
+		}
+		pods, podsTruncated, err := listOwnedResources(ctx, dynamicClient, podGVR, namespace, nil, owners)
+		if err != nil {
+			return nil, nil, err
+		}
+		related["Pod"] = mapRelated(pods, podGVK)
+		markTruncated("Pod", podsTruncated)
+
+	case "service":
+		sliceSelector := labels.SelectorFromSet(labels.Set{"kubernetes.io/service-name": resource.GetName()})
+		slices, err := dynamicClient.Resource(endpointSliceGVR).Namespace(namespace).List(ctx, relatedListOptions(sliceSelector.String()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list endpoint slices: %w", err)
+		}
+		related["EndpointSlice"] = mapRelated(slices.Items, endpointSliceGVK)
+		markTruncated("EndpointSlice", slices.GetContinue() != "")
+
+		// Services without a selector don't select pods directly
+		if selector, found, _ := unstructured.NestedStringMap(resource.Object, "spec", "selector"); found && len(selector) > 0 {
+			pods, err := dynamicClient.Resource(podGVR).Namespace(namespace).List(ctx, relatedListOptions(labels.SelectorFromSet(selector).String()))
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list pods: %w", err)
+			}
+			related["Pod"] = mapRelated(pods.Items, podGVK)
+			markTruncated("Pod", pods.GetContinue() != "")
+		}
+
+	default:
+		return nil, nil, nil
+	}
+
+	return related, truncated, nil
+}
+
+// relatedListOptions returns list options for a related-resource query, bounded by maxListLimit
+func relatedListOptions(labelSelector string) metav1.ListOptions {
+	listOptions := metav1.ListOptions{LabelSelector: labelSelector}
+	if maxListLimit > 0 {
+		listOptions.Limit = int64(maxListLimit)
+	}
+	return listOptions
+}
+
+// listOwnedResources lists resources owned by any of the given UIDs, narrowing the query with
+// the selector's spec.selector when available. The list is bounded by maxListLimit, and the
+// returned bool reports whether more resources were left unlisted.
+func listOwnedResources(ctx context.Context, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, namespace string, selectorSource *unstructured.Unstructured, owners []types.UID) ([]unstructured.Unstructured, bool, error) {
+	if len(owners) == 0 {
+		return nil, false, nil
+	}
+
+	listOptions := relatedListOptions("")
+	if selectorSource != nil {
+		selector, err := workloadSelector(selectorSource)
+		if err != nil {
+			return nil, false, err
+		}
+		listOptions.LabelSelector = selector.String()
+	}
+
+	list, err := dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+	}
+
+	ownerSet := make(map[types.UID]bool, len(owners))
+	for _, uid := range owners {
+		ownerSet[uid] = true
+	}
+
+	var owned []unstructured.Unstructured
+	for _, item := range list.Items {
+		for _, ref := range item.GetOwnerReferences() {
+			if ownerSet[ref.UID] {
+				owned = append(owned, item)
+				break
+			}
+		}
+	}
+	return owned, list.GetContinue() != "", nil
+}
+
+// workloadSelector converts a workload's spec.selector into a labels.Selector
+func workloadSelector(resource *unstructured.Unstructured) (labels.Selector, error) {
+	selectorMap, found, _ := unstructured.NestedMap(resource.Object, "spec", "selector")
+	if !found {
+		return labels.Everything(), nil
+	}
+
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, &labelSelector); err != nil {
+		return nil, fmt.Errorf("failed to parse selector: %w", err)
+	}
+
+	return metav1.LabelSelectorAsSelector(&labelSelector)
+}
+
+func mapRelated(items []unstructured.Unstructured, gvk schema.GroupVersionKind) []any {
+	return mapToK8sResourceListContent(&unstructured.UnstructuredList{Items: items}, gvk)
+}
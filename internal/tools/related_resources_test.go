@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestListOwnedResources(t *testing.T) {
+	newPod := func(name string, labels map[string]any, ownerUID string) runtime.Object {
+		metadata := map[string]any{"name": name, "namespace": "default", "labels": labels}
+		if ownerUID != "" {
+			metadata["ownerReferences"] = []any{
+				map[string]any{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "owner", "uid": ownerUID},
+			}
+		}
+		return &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "Pod", "metadata": metadata}}
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podGVR: "PodList"},
+		newPod("web-a", map[string]any{"app": "web"}, "rs-1"),
+		newPod("web-b", map[string]any{"app": "web"}, "rs-2"),
+		newPod("web-adopted", map[string]any{"app": "web"}, "rs-other"),
+		newPod("web-orphan", map[string]any{"app": "web"}, ""),
+		newPod("api-a", map[string]any{"app": "api"}, "rs-1"),
+	)
+
+	selectorSource := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"selector": map[string]any{"matchLabels": map[string]any{"app": "web"}}},
+	}}
+
+	tests := []struct {
+		name           string
+		selectorSource *unstructured.Unstructured
+		owners         []types.UID
+		want           []string
+	}{
+		{name: "owner and selector", selectorSource: selectorSource, owners: []types.UID{"rs-1"}, want: []string{"web-a"}},
+		{name: "several owners", selectorSource: selectorSource, owners: []types.UID{"rs-1", "rs-2"}, want: []string{"web-a", "web-b"}},
+		{name: "owner without selector", owners: []types.UID{"rs-1"}, want: []string{"api-a", "web-a"}},
+		{name: "selector matches no owned pods", selectorSource: selectorSource, owners: []types.UID{"rs-missing"}},
+		{name: "no owners", selectorSource: selectorSource},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owned, truncated, err := listOwnedResources(context.Background(), client, podGVR, "default", tt.selectorSource, tt.owners)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if truncated {
+				t.Error("expected an untruncated list")
+			}
+			var names []string
+			for _, item := range owned {
+				names = append(names, item.GetName())
+			}
+			if len(names) != len(tt.want) {
+				t.Fatalf("got %v, want %v", names, tt.want)
+			}
+			for i := range names {
+				if names[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", names, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestListOwnedResourcesBounded(t *testing.T) {
+	original := maxListLimit
+	SetMaxListLimit(2)
+	t.Cleanup(func() { SetMaxListLimit(original) })
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podGVR: "PodList"})
+
+	// The fake client drops ListOptions.Limit, so check the options and fake a server-side page
+	if limit := relatedListOptions("app=web").Limit; limit != 2 {
+		t.Errorf("expected list limit 2, got %d", limit)
+	}
+	client.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		list := &unstructured.UnstructuredList{Object: map[string]any{"apiVersion": "v1", "kind": "PodList"}}
+		list.SetContinue("next")
+		return true, list, nil
+	})
+
+	_, truncated, err := listOwnedResources(context.Background(), client, podGVR, "default", nil, []types.UID{"rs-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !truncated {
+		t.Error("expected a list with a continue token to be reported as truncated")
+	}
+}
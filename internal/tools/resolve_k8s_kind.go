@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+type resolveK8sKindParams struct {
+	Context string
+	Kind    string
+	Group   string
+	Version string
+}
+
+func RegisterResolveK8sKindMCPTool(s *server.MCPServer) {
+	addTool(s, newResolveK8sKindMCPTool(), resolveK8sKindHandler)
+}
+
+// Tool schema
+func newResolveK8sKindMCPTool() mcp.Tool {
+	return mcp.NewTool("resolve_k8s_kind", readOnlyToolOptions(
+		mcp.WithDescription("Resolve a kind, resource name, or short name (e.g. 'Deployment', 'deployments', 'deploy', 'hpa') to its canonical group, version, and resource, "+
+			"whether it is namespaced, and the group's preferred version. Use it to validate or correct a group/version guess before calling list or get tools."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The kind, plural/singular resource name, or short name to resolve. Matched case-insensitively."),
+			mcp.Required(),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("Optional API group to restrict matches to. Use an empty value for all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("Optional API version to validate. When omitted, each match reports the group's preferred version."),
+		),
+	)...)
+}
+
+// Tool handler
+func resolveK8sKindHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractResolveK8sKindParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	matches, err := k8s.ResolveKind(params.Context, params.Kind, params.Group, params.Version)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to resolve kind: %v", err)), nil
+	}
+	if len(matches) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("No resource type matching %q was found on this cluster%s. "+
+			"Use the list_k8s_api_resources tool to discover available resource types.", params.Kind, resolveFilterDescription(params))), nil
+	}
+
+	// Return as JSON
+	return toJSONToolResult(map[string]any{
+		"matches": matches,
+	})
+}
+
+// resolveFilterDescription describes the group/version filters for not-found messages
+func resolveFilterDescription(params *resolveK8sKindParams) string {
+	switch {
+	case params.Group != "" && params.Version != "":
+		return fmt.Sprintf(" in group %q version %q", params.Group, params.Version)
+	case params.Group != "":
+		return fmt.Sprintf(" in group %q", params.Group)
+	case params.Version != "":
+		return fmt.Sprintf(" at version %q", params.Version)
+	}
+	return ""
+}
+
+func extractResolveK8sKindParams(request mcp.CallToolRequest) (*resolveK8sKindParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		return nil, fmt.Errorf("%s must not be empty", kindProperty)
+	}
+
+	return &resolveK8sKindParams{
+		Context: context,
+		Kind:    kind,
+		Group:   request.GetString(groupProperty, ""),
+		Version: request.GetString(versionProperty, ""),
+	}, nil
+}
@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DriftReport is a compact, kind-agnostic health summary of a resource
+type DriftReport struct {
+	Kind          string             `json:"kind"`
+	Name          string             `json:"name"`
+	Namespace     string             `json:"namespace,omitempty"`
+	Healthy       bool               `json:"healthy"`
+	Conditions    []DriftCondition   `json:"conditions,omitempty"`
+	Discrepancies []DriftDiscrepancy `json:"discrepancies,omitempty"`
+}
+
+// DriftCondition is a single status condition
+type DriftCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// DriftDiscrepancy is a desired value that the observed status hasn't caught up to
+type DriftDiscrepancy struct {
+	Field    string `json:"field"`
+	Desired  int64  `json:"desired"`
+	Observed int64  `json:"observed"`
+}
+
+// driftCheck compares a desired count to an observed count; both are paths into the object
+type driftCheck struct {
+	desired  []string
+	observed []string
+}
+
+// driftChecks covers the common spec-vs-status count conventions used by built-in workloads and
+// most operators. Checks whose fields aren't present on the resource are skipped.
+var driftChecks = []driftCheck{
+	{desired: []string{"metadata", "generation"}, observed: []string{"status", "observedGeneration"}},
+	{desired: []string{"spec", "replicas"}, observed: []string{"status", "replicas"}},
+	{desired: []string{"spec", "replicas"}, observed: []string{"status", "readyReplicas"}},
+	{desired: []string{"spec", "replicas"}, observed: []string{"status", "updatedReplicas"}},
+	{desired: []string{"spec", "replicas"}, observed: []string{"status", "availableReplicas"}},
+	{desired: []string{"status", "desiredNumberScheduled"}, observed: []string{"status", "numberReady"}},
+	{desired: []string{"status", "desiredNumberScheduled"}, observed: []string{"status", "updatedNumberScheduled"}},
+	{desired: []string{"status", "desiredNumberScheduled"}, observed: []string{"status", "numberAvailable"}},
+}
+
+// healthConditionTypes are positive-polarity condition types where a non-True status means unhealthy
+var healthConditionTypes = map[string]bool{
+	"Ready":       true,
+	"Available":   true,
+	"Established": true,
+	"Synced":      true,
+}
+
+// buildDriftReport extracts status conditions and desired-vs-observed discrepancies from any
+// resource following Kubernetes conventions
+func buildDriftReport(resource *unstructured.Unstructured) DriftReport {
+	report := DriftReport{
+		Kind:      resource.GetKind(),
+		Name:      resource.GetName(),
+		Namespace: resource.GetNamespace(),
+		Healthy:   true,
+	}
+
+	if conditions, found, _ := unstructured.NestedSlice(resource.Object, "status", "conditions"); found {
+		for _, c := range conditions {
+			condMap, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			condition := DriftCondition{}
+			condition.Type, _, _ = unstructured.NestedString(condMap, "type")
+			condition.Status, _, _ = unstructured.NestedString(condMap, "status")
+			condition.Reason, _, _ = unstructured.NestedString(condMap, "reason")
+			condition.Message, _, _ = unstructured.NestedString(condMap, "message")
+			report.Conditions = append(report.Conditions, condition)
+
+			if healthConditionTypes[condition.Type] && condition.Status != "True" {
+				report.Healthy = false
+			}
+			// Deployments report a stalled rollout through the Progressing condition
+			if condition.Type == "Progressing" && condition.Reason == "ProgressDeadlineExceeded" {
+				report.Healthy = false
+			}
+		}
+	}
+
+	for _, check := range driftChecks {
+		desired, found, _ := unstructured.NestedInt64(resource.Object, check.desired...)
+		if !found {
+			continue
+		}
+		// A missing status count means zero observed, except for observedGeneration which
+		// some resources never report
+		observed, found, _ := unstructured.NestedInt64(resource.Object, check.observed...)
+		if !found && check.observed[len(check.observed)-1] == "observedGeneration" {
+			continue
+		}
+		if observed != desired {
+			report.Discrepancies = append(report.Discrepancies, DriftDiscrepancy{
+				Field:    strings.Join(check.desired, ".") + " vs " + strings.Join(check.observed, "."),
+				Desired:  desired,
+				Observed: observed,
+			})
+			report.Healthy = false
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBuildDriftReport(t *testing.T) {
+	tests := []struct {
+		name              string
+		object            map[string]any
+		wantHealthy       bool
+		wantDiscrepancies []string
+	}{
+		{
+			name: "healthy deployment",
+			object: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "web", "generation": int64(2)},
+				"spec":     map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(2),
+					"replicas":           int64(3),
+					"readyReplicas":      int64(3),
+					"updatedReplicas":    int64(3),
+					"availableReplicas":  int64(3),
+					"conditions": []any{
+						map[string]any{"type": "Available", "status": "True"},
+						map[string]any{"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable"},
+					},
+				},
+			},
+			wantHealthy: true,
+		},
+		{
+			name: "deployment with unready replicas",
+			object: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "web", "generation": int64(3)},
+				"spec":     map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(2),
+					"replicas":           int64(3),
+					"updatedReplicas":    int64(3),
+					"availableReplicas":  int64(1),
+					"readyReplicas":      int64(1),
+				},
+			},
+			wantHealthy: false,
+			wantDiscrepancies: []string{
+				"metadata.generation vs status.observedGeneration",
+				"spec.replicas vs status.readyReplicas",
+				"spec.replicas vs status.availableReplicas",
+			},
+		},
+		{
+			name: "daemonset with unavailable pods",
+			object: map[string]any{
+				"kind":     "DaemonSet",
+				"metadata": map[string]any{"name": "agent"},
+				"status": map[string]any{
+					"desiredNumberScheduled": int64(4),
+					"numberReady":            int64(4),
+					"updatedNumberScheduled": int64(4),
+					"numberAvailable":        int64(3),
+				},
+			},
+			wantHealthy:       false,
+			wantDiscrepancies: []string{"status.desiredNumberScheduled vs status.numberAvailable"},
+		},
+		{
+			name: "custom resource with failing Ready condition",
+			object: map[string]any{
+				"kind":     "Widget",
+				"metadata": map[string]any{"name": "w"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "False", "reason": "ReconcileError"},
+					},
+				},
+			},
+			wantHealthy: false,
+		},
+		{
+			name: "resource without status",
+			object: map[string]any{
+				"kind":     "ConfigMap",
+				"metadata": map[string]any{"name": "cfg"},
+			},
+			wantHealthy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := buildDriftReport(&unstructured.Unstructured{Object: tt.object})
+
+			if report.Healthy != tt.wantHealthy {
+				t.Errorf("expected healthy=%v, got %v (report: %+v)", tt.wantHealthy, report.Healthy, report)
+			}
+			if len(report.Discrepancies) != len(tt.wantDiscrepancies) {
+				t.Fatalf("expected %d discrepancies, got %+v", len(tt.wantDiscrepancies), report.Discrepancies)
+			}
+			for i, want := range tt.wantDiscrepancies {
+				if report.Discrepancies[i].Field != want {
+					t.Errorf("discrepancy %d: expected %q, got %q", i, want, report.Discrepancies[i].Field)
+				}
+			}
+		})
+	}
+}
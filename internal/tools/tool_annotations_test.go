@@ -12,10 +12,31 @@ func TestToolsDeclareReadOnlyAnnotations(t *testing.T) {
 		tool mcp.Tool
 	}{
 		{name: "list_k8s_resources", tool: newListK8sResourcesMCPTool()},
+		{name: "count_k8s_resources", tool: newCountK8sResourcesMCPTool()},
+		{name: "list_k8s_namespace_inventory", tool: newListK8sNamespaceInventoryMCPTool()},
+		{name: "list_k8s_contexts", tool: newListK8sContextsMCPTool()},
 		{name: "list_k8s_api_resources", tool: newListK8sAPIResourcesMCPTool()},
+		{name: "resolve_k8s_kind", tool: newResolveK8sKindMCPTool()},
 		{name: "get_k8s_resource", tool: newGetK8sResourceMCPTool()},
+		{name: "k8s_resource_exists", tool: newK8sResourceExistsMCPTool()},
 		{name: "get_k8s_metrics", tool: newGetK8sMetricsMCPTool()},
+		{name: "list_k8s_pods_on_node", tool: newListK8sPodsOnNodeMCPTool()},
 		{name: "get_k8s_pod_logs", tool: newGetK8sPodLogsMCPTool()},
+		{name: "get_k8s_pod_logs_by_selector", tool: newGetK8sPodLogsBySelectorMCPTool()},
+		{name: "wait_k8s_resource", tool: newWaitK8sResourceMCPTool()},
+		{name: "watch_k8s_resources", tool: newWatchK8sResourcesMCPTool()},
+		{name: "explain_k8s_resource", tool: newExplainK8sResourceMCPTool()},
+		{name: "check_k8s_service_endpoints", tool: newCheckK8sServiceEndpointsMCPTool()},
+		{name: "get_k8s_rollout_status", tool: newGetK8sRolloutStatusMCPTool()},
+		{name: "get_k8s_hpa_status", tool: newGetK8sHPAStatusMCPTool()},
+		{name: "list_k8s_workload_pods", tool: newListK8sWorkloadPodsMCPTool()},
+		{name: "list_k8s_warnings", tool: newListK8sWarningsMCPTool()},
+		{name: "list_k8s_resources_multi", tool: newListK8sResourcesMultiMCPTool()},
+		{name: "k8s_can_i", tool: newK8sCanIMCPTool()},
+		{name: "get_k8s_namespace_graph", tool: newGetK8sNamespaceGraphMCPTool()},
+		{name: "get_k8s_resource_schema", tool: newGetK8sResourceSchemaMCPTool()},
+		{name: "list_k8s_restarting_pods", tool: newListK8sRestartingPodsMCPTool()},
+		{name: "compare_k8s_pod_metrics", tool: newCompareK8sPodMetricsMCPTool()},
 	}
 
 	for _, tt := range tests {
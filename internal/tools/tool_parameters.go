@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxParameterSuggestionDistance is the largest edit distance for which an unknown parameter is
+// assumed to be a typo of a known one (e.g. namespce -> namespace)
+const maxParameterSuggestionDistance = 2
+
+// warnUnknownParameters wraps a handler so arguments missing from the tool's schema are reported
+// in the result. Parameter getters fall back to defaults for absent keys, so a typo like
+// 'namespce' would otherwise be silently ignored and the query would run cluster-wide.
+func warnUnknownParameters(tool mcp.Tool, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil || result == nil {
+			return result, err
+		}
+
+		if warning := unknownParametersWarning(tool, request.GetArguments()); warning != "" {
+			result.Content = append(result.Content, mcp.NewTextContent(warning))
+		}
+		return result, nil
+	}
+}
+
+// unknownParametersWarning describes the arguments not declared in the tool's input schema,
+// suggesting the closest known parameter for likely typos. Returns an empty string when every
+// argument is known.
+func unknownParametersWarning(tool mcp.Tool, args map[string]any) string {
+	var unknown []string
+	for name := range args {
+		if _, known := tool.InputSchema.Properties[name]; !known {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return ""
+	}
+	sort.Strings(unknown)
+
+	known := make([]string, 0, len(tool.InputSchema.Properties))
+	for name := range tool.InputSchema.Properties {
+		known = append(known, name)
+	}
+	sort.Strings(known)
+
+	descriptions := make([]string, 0, len(unknown))
+	for _, name := range unknown {
+		if suggestion := closestParameter(name, known); suggestion != "" {
+			descriptions = append(descriptions, fmt.Sprintf("'%s' (did you mean '%s'?)", name, suggestion))
+		} else {
+			descriptions = append(descriptions, fmt.Sprintf("'%s'", name))
+		}
+	}
+
+	return fmt.Sprintf("Warning: ignored unknown parameters for %s: %s. Valid parameters are: %s. Retry with the corrected names if these were meant to apply.",
+		tool.Name, strings.Join(descriptions, ", "), strings.Join(known, ", "))
+}
+
+// closestParameter returns the known parameter nearest to name by case-insensitive edit
+// distance, or an empty string if none is close enough to be a likely typo
+func closestParameter(name string, known []string) string {
+	best, bestDistance := "", maxParameterSuggestionDistance+1
+	for _, candidate := range known {
+		if distance := editDistance(strings.ToLower(name), strings.ToLower(candidate)); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// editDistance computes the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
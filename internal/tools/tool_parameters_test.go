@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestUnknownParametersWarning(t *testing.T) {
+	tool := newListK8sResourcesMCPTool()
+
+	tests := []struct {
+		name         string
+		args         map[string]any
+		wantWarning  bool
+		wantContains []string
+	}{
+		{name: "all known", args: map[string]any{"context": "prod", "kind": "Pod", "namespace": "default"}},
+		{
+			name:         "typo",
+			args:         map[string]any{"context": "prod", "kind": "Pod", "namespce": "default"},
+			wantWarning:  true,
+			wantContains: []string{"'namespce' (did you mean 'namespace'?)", "Valid parameters are:"},
+		},
+		{
+			name:         "case mismatch",
+			args:         map[string]any{"context": "prod", "kind": "Pod", "labelselector": "app=web"},
+			wantWarning:  true,
+			wantContains: []string{"did you mean 'labelSelector'?"},
+		},
+		{
+			name:         "unrelated",
+			args:         map[string]any{"context": "prod", "kind": "Pod", "verbose": true},
+			wantWarning:  true,
+			wantContains: []string{"'verbose'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warning := unknownParametersWarning(tool, tt.args)
+			if (warning != "") != tt.wantWarning {
+				t.Fatalf("expected warning: %v, got %q", tt.wantWarning, warning)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(warning, want) {
+					t.Errorf("expected warning to contain %q, got %q", want, warning)
+				}
+			}
+			if strings.Contains(warning, "verbose' (did you mean") {
+				t.Errorf("expected no suggestion for an unrelated parameter, got %q", warning)
+			}
+		})
+	}
+}
+
+func TestWarnUnknownParameters(t *testing.T) {
+	tool := mcp.NewTool("test_tool", mcp.WithString("namespace"))
+	handler := warnUnknownParameters(tool, func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"namespce": "default"}
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Content) != 2 {
+		t.Fatalf("expected result and warning content, got %d items", len(result.Content))
+	}
+	warning, ok := result.Content[1].(mcp.TextContent)
+	if !ok || !strings.Contains(warning.Text, "did you mean 'namespace'?") {
+		t.Errorf("expected typo warning, got %+v", result.Content[1])
+	}
+}
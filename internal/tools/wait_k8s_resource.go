@@ -0,0 +1,298 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+const (
+	forProperty     = "for"
+	timeoutProperty = "timeout"
+
+	defaultWaitTimeout = 60 * time.Second
+	maxWaitTimeout     = 5 * time.Minute
+	initialWaitBackoff = 1 * time.Second
+	maxWaitBackoff     = 10 * time.Second
+)
+
+type waitK8sResourceParams struct {
+	Context   string
+	Name      string
+	Namespace string
+	Group     string
+	Version   string
+	Kind      string
+	For       string
+	Timeout   time.Duration
+}
+
+// waitK8sResourceResult reports the outcome of polling a resource
+type waitK8sResourceResult struct {
+	Satisfied bool   `json:"satisfied"`
+	Condition string `json:"condition"`
+	Actual    string `json:"actual,omitempty"`
+	Expected  string `json:"expected,omitempty"`
+	Attempts  int    `json:"attempts"`
+	Elapsed   string `json:"elapsed"`
+	Error     string `json:"error,omitempty"`
+	Resource  any    `json:"resource,omitempty"`
+}
+
+// waitCondition is a parsed 'for' expression
+type waitCondition struct {
+	// conditionType is set for condition=<type>[=<status>] expressions
+	conditionType   string
+	conditionStatus string
+	// jsonPath is set for jsonpath=<expr>=<value> expressions; the value may itself be a JSONPath expression
+	jsonPath string
+	value    string
+}
+
+func RegisterWaitK8sResourceMCPTool(s *server.MCPServer) {
+	addTool(s, newWaitK8sResourceMCPTool(), waitK8sResourceHandler)
+}
+
+// Tool schema
+func newWaitK8sResourceMCPTool() mcp.Tool {
+	return mcp.NewTool("wait_k8s_resource", readOnlyToolOptions(
+		mcp.WithDescription("Poll a single Kubernetes resource until a condition is satisfied or a timeout elapses, similar to kubectl wait. Read-only: only repeatedly fetches the resource."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(nameProperty,
+			mcp.Description("The name of the resource to wait for."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to use. Required for namespaced resources."),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The Kubernetes resource Kind."),
+			mcp.Required(),
+		),
+		mcp.WithString(forProperty,
+			mcp.Description("The condition to wait for. Either 'condition=<type>[=<status>]' (e.g. 'condition=Available', status defaults to True) or "+
+				"'jsonpath=<expr>=<value>' (e.g. 'jsonpath={.status.phase}=Running'). The value may also be a JSONPath expression to compare two fields, "+
+				"e.g. 'jsonpath={.status.availableReplicas}={.spec.replicas}'."),
+			mcp.Required(),
+		),
+		mcp.WithString(timeoutProperty,
+			mcp.Description("Maximum time to wait (e.g., '30s', '2m'). Defaults to 60s, maximum 5m."),
+		),
+	)...)
+}
+
+// Tool handler
+func waitK8sResourceHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractWaitK8sResourceParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	condition, err := parseWaitCondition(params.For)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Create GVK
+	gvk := schema.GroupVersionKind{
+		Group:   params.Group,
+		Version: params.Version,
+		Kind:    params.Kind,
+	}
+
+	// Convert GVK to GVR, using the version the cluster actually serves
+	mapping, err := k8s.GVKToRESTMapping(params.Context, gvk)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	gvk, gvr := mapping.GroupVersionKind, mapping.Resource
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+
+	// Poll with exponential backoff until satisfied or timed out
+	start := time.Now()
+	deadline := start.Add(params.Timeout)
+	backoff := initialWaitBackoff
+	result := waitK8sResourceResult{Condition: params.For}
+	var resource *unstructured.Unstructured
+
+	for {
+		result.Attempts++
+		resource, err = getK8sResource(ctx, dynamicClient, gvr, params.Namespace, params.Name)
+		if err != nil {
+			result.Error = categorizeK8sError("get", gvr, params.Namespace, params.Name, err).Error()
+		} else {
+			result.Error = ""
+			satisfied, actual, expected, evalErr := condition.evaluate(resource)
+			if evalErr != nil {
+				return mcp.NewToolResultError(evalErr.Error()), nil
+			}
+			result.Satisfied, result.Actual, result.Expected = satisfied, actual, expected
+			if satisfied {
+				break
+			}
+		}
+
+		// Stop when the next poll would exceed the timeout
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		wait := min(backoff, remaining)
+
+		select {
+		case <-ctx.Done():
+			return mcp.NewToolResultError(fmt.Sprintf("Wait cancelled: %v", ctx.Err())), nil
+		case <-time.After(wait):
+		}
+		backoff = min(backoff*2, maxWaitBackoff)
+	}
+
+	result.Elapsed = time.Since(start).Round(time.Second).String()
+	if resource != nil {
+		result.Resource = mapToK8sResourceContent(resource, gvk)
+	}
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+func extractWaitK8sResourceParams(request mcp.CallToolRequest) (*waitK8sResourceParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := request.RequireString(nameProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	forCondition, err := request.RequireString(forProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse and clamp timeout (default to 60s)
+	timeout := defaultWaitTimeout
+	if timeoutStr := request.GetString(timeoutProperty, ""); timeoutStr != "" {
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout duration: %w", err)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("timeout must be positive, got %s", timeoutStr)
+		}
+		timeout = min(timeout, maxWaitTimeout)
+	}
+
+	return &waitK8sResourceParams{
+		Context:   context,
+		Name:      name,
+		Namespace: request.GetString(namespaceProperty, ""),
+		Group:     request.GetString(groupProperty, ""),
+		Version:   request.GetString(versionProperty, ""),
+		Kind:      kind,
+		For:       forCondition,
+		Timeout:   timeout,
+	}, nil
+}
+
+// parseWaitCondition parses a kubectl wait style 'for' expression
+func parseWaitCondition(expr string) (*waitCondition, error) {
+	switch {
+	case strings.HasPrefix(expr, "condition="):
+		conditionType, conditionStatus, _ := strings.Cut(strings.TrimPrefix(expr, "condition="), "=")
+		if conditionType == "" {
+			return nil, fmt.Errorf("condition type must not be empty in %q", expr)
+		}
+		if conditionStatus == "" {
+			conditionStatus = "True"
+		}
+		return &waitCondition{conditionType: conditionType, conditionStatus: conditionStatus}, nil
+
+	case strings.HasPrefix(expr, "jsonpath="):
+		rest := strings.TrimPrefix(expr, "jsonpath=")
+		// The JSONPath expression is wrapped in braces, so split at the '=' following the closing brace
+		end := strings.Index(rest, "}=")
+		if !strings.HasPrefix(rest, "{") || end == -1 {
+			return nil, fmt.Errorf("jsonpath condition must have the form 'jsonpath={<expr>}=<value>', got %q", expr)
+		}
+		return &waitCondition{jsonPath: rest[:end+1], value: rest[end+2:]}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported condition %q: must start with 'condition=' or 'jsonpath='", expr)
+	}
+}
+
+// evaluate checks the condition against a resource, returning the actual and expected values
+func (c *waitCondition) evaluate(resource *unstructured.Unstructured) (bool, string, string, error) {
+	if c.conditionType != "" {
+		conditions, _, _ := unstructured.NestedSlice(resource.Object, "status", "conditions")
+		for _, condition := range conditions {
+			if condMap, ok := condition.(map[string]any); ok {
+				if condType, _, _ := unstructured.NestedString(condMap, "type"); strings.EqualFold(condType, c.conditionType) {
+					status, _, _ := unstructured.NestedString(condMap, "status")
+					return strings.EqualFold(status, c.conditionStatus), status, c.conditionStatus, nil
+				}
+			}
+		}
+		return false, "", c.conditionStatus, nil
+	}
+
+	actual, err := evaluateJSONPath(resource, c.jsonPath)
+	if err != nil {
+		return false, "", "", err
+	}
+
+	expected := c.value
+	if strings.HasPrefix(expected, "{") && strings.HasSuffix(expected, "}") {
+		if expected, err = evaluateJSONPath(resource, c.value); err != nil {
+			return false, actual, "", err
+		}
+	}
+
+	return actual == expected, actual, expected, nil
+}
+
+// evaluateJSONPath renders a JSONPath expression against a resource, treating missing fields as empty
+func evaluateJSONPath(resource *unstructured.Unstructured, expr string) (string, error) {
+	parser := jsonpath.New("wait").AllowMissingKeys(true)
+	if err := parser.Parse(expr); err != nil {
+		return "", fmt.Errorf("invalid JSONPath expression %q: %w", expr, err)
+	}
+
+	var buf strings.Builder
+	if err := parser.Execute(&buf, resource.Object); err != nil {
+		return "", fmt.Errorf("failed to evaluate JSONPath expression %q: %w", expr, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWaitConditionEvaluate(t *testing.T) {
+	resource := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"replicas": int64(3)},
+		"status": map[string]any{
+			"phase":             "Running",
+			"availableReplicas": int64(2),
+			"conditions": []any{
+				map[string]any{"type": "Available", "status": "True"},
+				map[string]any{"type": "Progressing", "status": "False"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		expr      string
+		satisfied bool
+	}{
+		{"condition=Available", true},
+		{"condition=available=true", true},
+		{"condition=Progressing", false},
+		{"condition=Progressing=False", true},
+		{"condition=Missing", false},
+		{"jsonpath={.status.phase}=Running", true},
+		{"jsonpath={.status.phase}=Pending", false},
+		{"jsonpath={.status.availableReplicas}={.spec.replicas}", false},
+		{"jsonpath={.spec.replicas}=3", true},
+		{"jsonpath={.status.missing}=", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			condition, err := parseWaitCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("parseWaitCondition(%q) returned error: %v", tt.expr, err)
+			}
+
+			satisfied, _, _, err := condition.evaluate(resource)
+			if err != nil {
+				t.Fatalf("evaluate(%q) returned error: %v", tt.expr, err)
+			}
+			if satisfied != tt.satisfied {
+				t.Errorf("evaluate(%q) = %t, want %t", tt.expr, satisfied, tt.satisfied)
+			}
+		})
+	}
+}
+
+func TestParseWaitConditionInvalid(t *testing.T) {
+	for _, expr := range []string{"", "delete", "condition=", "jsonpath=.status.phase=Running", "jsonpath={.status.phase}"} {
+		if _, err := parseWaitCondition(expr); err == nil {
+			t.Errorf("parseWaitCondition(%q) expected error, got nil", expr)
+		}
+	}
+}
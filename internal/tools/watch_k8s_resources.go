@@ -0,0 +1,303 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/krmcbride/mcp-k8s/internal/k8s"
+)
+
+const (
+	maxEventsProperty = "maxEvents"
+
+	defaultWatchTimeout   = 30 * time.Second
+	maxWatchTimeout       = 5 * time.Minute
+	defaultWatchMaxEvents = 100
+)
+
+// Reasons a watch stopped, reported in the result
+const (
+	watchStoppedTimeout   = "timeout"
+	watchStoppedMaxEvents = "maxEvents"
+	watchStoppedClosed    = "closed"
+	watchStoppedError     = "error"
+	watchStoppedCanceled  = "canceled"
+)
+
+type watchK8sResourcesParams struct {
+	Context       string
+	Namespace     string
+	AllNamespaces bool
+	Group         string
+	Version       string
+	Kind          string
+	FieldSelector string
+	LabelSelector string
+	Timeout       time.Duration
+	MaxEvents     int
+}
+
+// WatchEvent is a single add/update/delete observed while watching
+type WatchEvent struct {
+	Type      string `json:"type"` // ADDED, MODIFIED, DELETED
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Time      string `json:"time"`
+	Resource  any    `json:"resource,omitempty"` // Mapped content of the object after the change
+}
+
+// WatchResult holds the events observed during a bounded watch and why it stopped
+type WatchResult struct {
+	Kind           string       `json:"kind"`
+	Namespace      string       `json:"namespace,omitempty"`
+	Events         []WatchEvent `json:"events"`
+	StoppedBecause string       `json:"stoppedBecause"`
+	Error          string       `json:"error,omitempty"`
+}
+
+func RegisterWatchK8sResourcesMCPTool(s *server.MCPServer) {
+	addTool(s, newWatchK8sResourcesMCPTool(), watchK8sResourcesHandler)
+}
+
+// Tool schema
+func newWatchK8sResourcesMCPTool() mcp.Tool {
+	return mcp.NewTool("watch_k8s_resources", readOnlyToolOptions(
+		mcp.WithDescription("Watch resources of a kind for changes (add/update/delete) for a bounded time, like kubectl get -w. Only changes after the call starts are reported. "+
+			"Each event is streamed as an MCP progress notification when the client sends a progress token, and all events are returned when the watch stops. Read-only: it never modifies resources."),
+		mcp.WithString(contextProperty,
+			mcp.Description("The Kubernetes context to use. To discover available contexts or resolve cluster aliases use the kubeconfig://contexts MCP resource."),
+			mcp.Required(),
+		),
+		mcp.WithString(namespaceProperty,
+			mcp.Description("The Kubernetes namespace to watch. Defaults to the context's configured namespace, or all namespaces if the context doesn't set one. Ignored for cluster-scoped resources."),
+		),
+		mcp.WithBoolean(allNamespacesProperty,
+			mcp.Description("Watch across all namespaces, ignoring the context's default namespace. Cannot be used with namespace."),
+		),
+		mcp.WithString(groupProperty,
+			mcp.Description("The Kubernetes resource API Group. If omitted and the kind isn't in the core group, the kind is looked up across all groups."),
+		),
+		mcp.WithString(versionProperty,
+			mcp.Description("The Kubernetes resource API Version. Defaults to the cluster's preferred version for the kind, which is also used if the given version isn't served."),
+		),
+		mcp.WithString(kindProperty,
+			mcp.Description("The Kubernetes resource Kind."),
+			mcp.Required(),
+		),
+		mcp.WithString(fieldSelectorProperty,
+			mcp.Description("Field selector to filter watched resources server-side (e.g. 'status.phase!=Running')."),
+		),
+		mcp.WithString(labelSelectorProperty,
+			mcp.Description("Label selector to filter watched resources server-side (e.g. 'app=web')."),
+		),
+		mcp.WithString(timeoutProperty,
+			mcp.Description("How long to watch (e.g., '30s', '2m'). Defaults to 30s, maximum 5m."),
+		),
+		mcp.WithNumber(maxEventsProperty,
+			mcp.Description("Stop after this many events. Defaults to 100."),
+		),
+	)...)
+}
+
+// Tool handler
+func watchK8sResourcesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract and validate parameters
+	params, err := extractWatchK8sResourcesParams(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   params.Group,
+		Version: params.Version,
+		Kind:    params.Kind,
+	}
+
+	// Resolve the resource and its scope
+	mapping, err := k8s.GVKToRESTMapping(params.Context, gvk)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	gvk = mapping.GroupVersionKind
+
+	namespace, err := resolveNamespace(params.Context, mapping, params.Namespace, params.AllNamespaces)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Get dynamic client
+	dynamicClient, err := k8s.GetDynamicClientForContext(params.Context)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create dynamic client: %v", err)), nil
+	}
+	resourceClient := dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+
+	// Start from the current resource version so existing objects aren't replayed as ADDED events
+	listOptions := metav1.ListOptions{
+		FieldSelector: params.FieldSelector,
+		LabelSelector: params.LabelSelector,
+	}
+	initialListOptions := listOptions
+	initialListOptions.Limit = 1
+	current, err := resourceClient.List(ctx, initialListOptions)
+	if err != nil {
+		if params.FieldSelector != "" && apierrors.IsBadRequest(err) {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to watch resources: %v. %s", err, unsupportedFieldSelectorHint)), nil
+		}
+		return mcp.NewToolResultError(categorizeK8sError("watch", mapping.Resource, namespace, "", err).Error()), nil
+	}
+	listOptions.ResourceVersion = current.GetResourceVersion()
+
+	watchCtx, cancel := context.WithTimeout(ctx, params.Timeout)
+	defer cancel()
+
+	watcher, err := resourceClient.Watch(watchCtx, listOptions)
+	if err != nil {
+		return mcp.NewToolResultError(categorizeK8sError("watch", mapping.Resource, namespace, "", err).Error()), nil
+	}
+	defer watcher.Stop()
+
+	result := WatchResult{
+		Kind:      mapping.GroupVersionKind.Kind,
+		Namespace: namespace,
+	}
+	notify := watchProgressNotifier(ctx, request)
+	result.Events, result.StoppedBecause, result.Error = collectWatchEvents(watchCtx, watcher, mapping.GroupVersionKind, params.MaxEvents, notify)
+
+	// Reaching the deadline is the normal way for a watch to end
+	if result.StoppedBecause == watchStoppedCanceled && ctx.Err() == nil {
+		result.StoppedBecause = watchStoppedTimeout
+	}
+
+	// Return as JSON
+	return toJSONToolResult(result)
+}
+
+// collectWatchEvents reads events from a watch until the context ends, the watch closes, an error
+// event arrives, or maxEvents have been collected, calling notify for each event as it arrives
+func collectWatchEvents(ctx context.Context, watcher watch.Interface, gvk schema.GroupVersionKind, maxEvents int, notify func(WatchEvent, int)) ([]WatchEvent, string, string) {
+	events := []WatchEvent{}
+	for {
+		select {
+		case <-ctx.Done():
+			return events, watchStoppedCanceled, ""
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return events, watchStoppedClosed, ""
+			}
+
+			switch event.Type {
+			case watch.Error:
+				return events, watchStoppedError, apierrors.FromObject(event.Object).Error()
+			case watch.Bookmark:
+				continue
+			}
+
+			object, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			watchEvent := WatchEvent{
+				Type:      string(event.Type),
+				Name:      object.GetName(),
+				Namespace: object.GetNamespace(),
+				Time:      time.Now().UTC().Format(time.RFC3339),
+				Resource:  mapToK8sResourceContent(object, gvk),
+			}
+			events = append(events, watchEvent)
+			if notify != nil {
+				notify(watchEvent, len(events))
+			}
+
+			if maxEvents > 0 && len(events) >= maxEvents {
+				return events, watchStoppedMaxEvents, ""
+			}
+		}
+	}
+}
+
+// watchProgressNotifier streams each event to the client as a progress notification when the
+// request carries a progress token. Returns nil when the client didn't ask for progress.
+func watchProgressNotifier(ctx context.Context, request mcp.CallToolRequest) func(WatchEvent, int) {
+	mcpServer := server.ServerFromContext(ctx)
+	if mcpServer == nil || request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return nil
+	}
+	token := request.Params.Meta.ProgressToken
+
+	return func(event WatchEvent, count int) {
+		name := event.Name
+		if event.Namespace != "" {
+			name = event.Namespace + "/" + name
+		}
+		// Notifications are best effort; the final result still contains every event
+		_ = mcpServer.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+			"progressToken": token,
+			"progress":      count,
+			"message":       fmt.Sprintf("%s %s", event.Type, name),
+		})
+	}
+}
+
+func extractWatchK8sResourcesParams(request mcp.CallToolRequest) (*watchK8sResourcesParams, error) {
+	context, err := request.RequireString(contextProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := request.RequireString(kindProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := request.GetString(namespaceProperty, "")
+	allNamespaces := request.GetBool(allNamespacesProperty, false)
+	if namespace != "" && allNamespaces {
+		return nil, fmt.Errorf("cannot specify both '%s' and '%s' parameters", namespaceProperty, allNamespacesProperty)
+	}
+
+	fieldSelector, err := normalizeFieldSelector(request.GetString(fieldSelectorProperty, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse and clamp timeout (default to 30s)
+	timeout := defaultWatchTimeout
+	if timeoutStr := request.GetString(timeoutProperty, ""); timeoutStr != "" {
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout duration: %w", err)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("timeout must be positive, got %s", timeoutStr)
+		}
+		timeout = min(timeout, maxWatchTimeout)
+	}
+
+	maxEvents := request.GetInt(maxEventsProperty, defaultWatchMaxEvents)
+	if maxEvents <= 0 {
+		return nil, fmt.Errorf("%s must be positive, got %d", maxEventsProperty, maxEvents)
+	}
+
+	return &watchK8sResourcesParams{
+		Context:       context,
+		Namespace:     namespace,
+		AllNamespaces: allNamespaces,
+		Group:         request.GetString(groupProperty, ""),
+		Version:       request.GetString(versionProperty, ""),
+		Kind:          kind,
+		FieldSelector: fieldSelector,
+		LabelSelector: request.GetString(labelSelectorProperty, ""),
+		Timeout:       timeout,
+		MaxEvents:     maxEvents,
+	}, nil
+}
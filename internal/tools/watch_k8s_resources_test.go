@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestCollectWatchEvents(t *testing.T) {
+	newPod := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": name, "namespace": "default"},
+		}}
+	}
+
+	t.Run("stops at max events", func(t *testing.T) {
+		watcher := watch.NewFakeWithChanSize(3, false)
+		watcher.Add(newPod("a"))
+		watcher.Modify(newPod("a"))
+		watcher.Delete(newPod("a"))
+
+		var notified []string
+		events, stopped, errMsg := collectWatchEvents(context.Background(), watcher, podGVK, 2, func(event WatchEvent, count int) {
+			notified = append(notified, event.Type)
+		})
+		if stopped != watchStoppedMaxEvents || errMsg != "" {
+			t.Errorf("expected to stop at maxEvents, got %q (%s)", stopped, errMsg)
+		}
+		if len(events) != 2 || events[0].Type != "ADDED" || events[1].Type != "MODIFIED" {
+			t.Errorf("expected ADDED and MODIFIED events, got %+v", events)
+		}
+		if len(notified) != 2 {
+			t.Errorf("expected 2 notifications, got %v", notified)
+		}
+	})
+
+	t.Run("error event", func(t *testing.T) {
+		watcher := watch.NewFakeWithChanSize(1, false)
+		watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Message: "too old resource version", Reason: metav1.StatusReasonExpired, Code: 410})
+
+		events, stopped, errMsg := collectWatchEvents(context.Background(), watcher, podGVK, 10, nil)
+		if stopped != watchStoppedError || errMsg == "" {
+			t.Errorf("expected to stop with an error, got %q (%s)", stopped, errMsg)
+		}
+		if len(events) != 0 {
+			t.Errorf("expected no events, got %+v", events)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, stopped, _ := collectWatchEvents(ctx, watch.NewFake(), podGVK, 10, nil)
+		if stopped != watchStoppedCanceled {
+			t.Errorf("expected to stop when canceled, got %q", stopped)
+		}
+	})
+}